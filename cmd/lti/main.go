@@ -11,9 +11,15 @@ import (
 
 type CLI struct {
 	Version  kong.VersionFlag    `short:"v" help:"Show version."`
-	Build    command.BuildCmd    `          help:"Build the index for a given year."  cmd:"" name:"build"`
-	Today    command.TodayCmd    `          help:"Get the entry for a specific date." cmd:"" name:"today"`
-	Validate command.ValidateCmd `          help:"Validate the plan file."            cmd:"" name:"validate"`
+	Build    command.BuildCmd    `          help:"Build the index for a given year."   cmd:"" name:"build"`
+	Today    command.TodayCmd    `          help:"Get the entry for a specific date."  cmd:"" name:"today"`
+	Validate command.ValidateCmd `          help:"Validate the plan file."             cmd:"" name:"validate"`
+	Calendar command.CalendarCmd `          help:"Work with the computed calendar."    cmd:"" name:"calendar"`
+	Resolve  command.ResolveCmd  `          help:"Find the dates of a liturgical day." cmd:"" name:"resolve"`
+	Query    command.QueryCmd    `          help:"Select days with a query."           cmd:"" name:"query"`
+	Stats    command.StatsCmd    `          help:"Report liturgical-year statistics."  cmd:"" name:"stats"`
+	Map      command.MapCmd      `          help:"Carry dates to another year."        cmd:"" name:"map"`
+	Coverage command.CoverageCmd `          help:"Report how the plan covers a year."  cmd:"" name:"coverage"`
 }
 
 func main() {
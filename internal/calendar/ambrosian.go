@@ -0,0 +1,88 @@
+package calendar
+
+import (
+	"slices"
+	"time"
+)
+
+// ambrosianAdventSunday returns the First Sunday of Advent in the Ambrosian rite: the Sunday after the feast of
+// St Martin (11 November), two weeks before the Roman Advent.
+func ambrosianAdventSunday(year int) time.Time {
+	return sundayAfter(fixedDate(year, time.November, 11))
+}
+
+// ambrosianCycle returns the season transitions of the Ambrosian rite (Milan) anchored in the given year.
+// Advent lasts six weeks, and Lent begins on the Sunday after the Roman Ash Wednesday: the rite has no Ash
+// Wednesday, so the days before that Sunday still belong to the season after the Epiphany.
+func (ce *CalendarEngine) ambrosianCycle(year int) []seasonTransition {
+	easter := ce.easter(year, AmbrosianCalendar)
+
+	return []seasonTransition{
+		transition(Epiphanytide, ce.epiphany(year, AmbrosianCalendar)),
+		transition(Lent, easter.AddDate(0, 0, -42)),
+		transition(Triduum, easter.AddDate(0, 0, -3)),
+		transition(Eastertide, easter),
+		transition(Ordinary, easter.AddDate(0, 0, 50)),
+		transition(Advent, ce.advent(year, AmbrosianCalendar)),
+		transition(Christmastide, fixedDate(year, time.December, 25)),
+	}
+}
+
+// ambrosianHolidays returns the names and dates of the movable feasts of the Ambrosian rite for a year.
+func (ce *CalendarEngine) ambrosianHolidays(year int) ([]string, []time.Time) {
+	easter := ce.easter(year, AmbrosianCalendar)
+
+	names := []string{
+		"First Sunday of Lent",
+		"Holy Thursday",
+		"Good Friday",
+		"Easter Sunday",
+		"Easter Monday",
+		"Pentecost",
+		"First Sunday of Advent",
+	}
+	dates := []time.Time{
+		easter.AddDate(0, 0, -42),
+		easter.AddDate(0, 0, -3),
+		easter.AddDate(0, 0, -2),
+		easter,
+		easter.AddDate(0, 0, 1),
+		easter.AddDate(0, 0, 49),
+		ce.advent(year, AmbrosianCalendar),
+	}
+	return names, dates
+}
+
+var ambrosianFixedFeasts = append(slices.Clone(romanFixedFeasts),
+	fixedFeast{time.November, 4, feast{"Saint Charles Borromeo", White, rankPrincipal}},
+	fixedFeast{time.December, 7, feast{"Saint Ambrose", White, rankPrincipal}},
+)
+
+var ambrosianMovableFeasts = []movableFeast{
+	{-42, feast{"Sunday at the Beginning of Lent", Violet, rankPrincipal}},
+	{-7, feast{"Palm Sunday", Red, rankPrincipal}},
+	{-3, feast{"Holy Thursday", White, rankPrincipal}},
+	{-2, feast{"Good Friday", Red, rankPrincipal}},
+	{-1, feast{"Holy Saturday", Violet, rankPrincipal}},
+	{0, feast{"Easter Sunday", White, rankPrincipal}},
+	{39, feast{"The Ascension of the Lord", White, rankPrincipal}},
+	{49, feast{"Pentecost", Red, rankPrincipal}},
+	{56, feast{"The Most Holy Trinity", White, rankPrincipal}},
+	{60, feast{"The Most Holy Body and Blood of Christ", White, rankPrincipal}},
+}
+
+// ambrosianSundayFeasts returns the Ambrosian feasts kept on a Sunday fixed relative to a civil date.
+func ambrosianSundayFeasts(ce *CalendarEngine, year int) []datedFeast {
+	advent := ce.advent(year, AmbrosianCalendar)
+
+	// The Holy Family is kept on the last Sunday of January.
+	holyFamily := sundayOnOrAfter(fixedDate(year, time.January, 25))
+
+	return []datedFeast{
+		{ce.baptism(year, AmbrosianCalendar), feast{"The Baptism of the Lord", White, rankPrincipal}},
+		{holyFamily, feast{"The Holy Family", White, rankPrincipal}},
+		{advent.AddDate(0, 0, -7), feast{"Christ the King", White, rankPrincipal}},
+		// The sixth and last Sunday of Advent celebrates the Incarnation.
+		{advent.AddDate(0, 0, 35), feast{"The Divine Motherhood of Mary", White, rankPrincipal}},
+	}
+}
@@ -0,0 +1,96 @@
+package calendar_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+)
+
+func TestAmbrosianSeasons(t *testing.T) {
+	ce := calendar.NewCalendarEngine()
+
+	// Easter 2025 is 20 April, so the Roman Ash Wednesday is 5 March. The Roman Advent begins on 30 November.
+	testCases := []struct {
+		date        string
+		season      calendar.LiturgicalSeason
+		week        int
+		celebration string
+	}{
+		{"2025-01-26", calendar.Epiphanytide, 3, "The Holy Family"},
+		{"2025-03-05", calendar.Epiphanytide, 9, ""},
+		{"2025-03-08", calendar.Epiphanytide, 9, ""},
+		{"2025-03-09", calendar.Lent, 1, "Sunday at the Beginning of Lent"},
+		{"2025-04-17", calendar.Triduum, 1, "Holy Thursday"},
+		{"2025-06-09", calendar.Ordinary, 1, ""},
+		{"2025-11-09", calendar.Ordinary, 22, "Christ the King"},
+		{"2025-11-11", calendar.Ordinary, 23, ""},
+		{"2025-11-16", calendar.Advent, 1, ""},
+		{"2025-12-07", calendar.Advent, 4, "Saint Ambrose"},
+		{"2025-12-21", calendar.Advent, 6, "The Divine Motherhood of Mary"},
+		{"2025-12-25", calendar.Christmastide, 1, "The Nativity of the Lord"},
+		// Before the Gregorian reform St Martin, Christmas and the Epiphany keep their Julian dates, nine days
+		// later in 1400.
+		{"1400-01-01", calendar.Advent, 6, ""},
+		{"1400-01-02", calendar.Christmastide, 1, "The Nativity of the Lord"},
+		{"1400-01-14", calendar.Epiphanytide, 1, "The Epiphany of the Lord"},
+		{"1400-02-02", calendar.Epiphanytide, 3, "The Holy Family"},
+		{"1400-11-22", calendar.Ordinary, 23, ""},
+		{"1400-11-23", calendar.Advent, 1, ""},
+		{"1400-12-16", calendar.Advent, 4, "Saint Ambrose"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.date, func(t *testing.T) {
+			dayKey, err := ce.GetRomanDay(tc.date, calendar.AmbrosianCalendar)
+			if err != nil {
+				t.Fatalf("GetRomanDay failed: %v", err)
+			}
+
+			if dayKey.Season != tc.season {
+				t.Errorf("Expected season %s, got %s", tc.season, dayKey.Season)
+			}
+			if dayKey.SeasonWeek != tc.week {
+				t.Errorf("Expected season week %d, got %d", tc.week, dayKey.SeasonWeek)
+			}
+			if dayKey.Celebration != tc.celebration {
+				t.Errorf("Expected celebration %q, got %q", tc.celebration, dayKey.Celebration)
+			}
+		})
+	}
+}
+
+func TestAmbrosianHasNoAshWednesday(t *testing.T) {
+	ce := calendar.NewCalendarEngine()
+
+	for _, year := range []int{1600, 2024, 2038} {
+		holidays, err := ce.Holidays(year, calendar.AmbrosianCalendar)
+		if err != nil {
+			t.Fatalf("Holidays failed: %v", err)
+		}
+		if _, ok := holidays["Ash Wednesday"]; ok {
+			t.Errorf("Unexpected Ash Wednesday in %d", year)
+		}
+
+		lent := holidays["First Sunday of Lent"]
+		if lent.Season != calendar.Lent || lent.SeasonWeek != 1 || lent.Weekday != calendar.Sunday {
+			t.Errorf("Expected Lent to begin on a Sunday in %d, got %+v", year, lent)
+		}
+
+		// Advent begins after St Martin and its sixth Sunday celebrates the Incarnation.
+		advent := holidays["First Sunday of Advent"]
+		if day := advent.Date[5:]; day < "11-12" || day > "11-18" {
+			t.Errorf("Expected Advent to begin between 12 and 18 November in %d, got %s", year, advent.Date)
+		}
+		days, err := ce.GenerateRomanCalendar(strconv.Itoa(year), calendar.AmbrosianCalendar)
+		if err != nil {
+			t.Fatalf("GenerateRomanCalendar failed: %v", err)
+		}
+		for _, day := range days {
+			if day.Celebration == "The Divine Motherhood of Mary" &&
+				(day.Season != calendar.Advent || day.SeasonWeek != 6) {
+				t.Errorf("Expected the sixth Sunday of Advent in %d, got %+v", year, day)
+			}
+		}
+	}
+}
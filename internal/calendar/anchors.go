@@ -0,0 +1,141 @@
+package calendar
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/julianstephens/go-utils/generic"
+)
+
+// Anchor names a date of the liturgical year that other dates are reckoned from, such as Easter or the First
+// Sunday of Advent.
+type Anchor string
+
+const (
+	AnchorEaster       Anchor = "easter"
+	AnchorAshWednesday Anchor = "ash-wednesday"
+	AnchorPalmSunday   Anchor = "palm-sunday"
+	AnchorAscension    Anchor = "ascension"
+	AnchorPentecost    Anchor = "pentecost"
+	AnchorTrinity      Anchor = "trinity"
+	AnchorAdvent1      Anchor = "advent1"
+	AnchorChristmas    Anchor = "christmas"
+	AnchorEpiphany     Anchor = "epiphany"
+)
+
+// ParseAnchor parses an anchor name such as "easter" or "ash-wednesday".
+func ParseAnchor(name string) (Anchor, error) {
+	anchor := Anchor(strings.ToLower(strings.TrimSpace(name)))
+	switch anchor {
+	case AnchorEaster, AnchorAshWednesday, AnchorPalmSunday, AnchorAscension, AnchorPentecost, AnchorTrinity,
+		AnchorAdvent1, AnchorChristmas, AnchorEpiphany:
+		return anchor, nil
+	default:
+		return "", &CalendarError{
+			Message: generic.Ptr("unknown anchor: " + name),
+			Err:     ErrValidationFailed,
+		}
+	}
+}
+
+// Anchors returns the anchor dates of the tradition that fall in the given year; before the Gregorian reform,
+// Christmas can fall in the January that follows. Anchors a tradition does not keep are omitted: the Ambrosian
+// rite has no Ash Wednesday, and the Coptic and Ethiopian churches do not reckon from Ash Wednesday, Trinity
+// Sunday or Advent.
+func (ce *CalendarEngine) Anchors(year int, tradition CalendarTradition) (map[Anchor]time.Time, error) {
+	var easter, christmas, epiphany time.Time
+	switch tradition {
+	case RomanCalendar, AmbrosianCalendar, AnglicanCalendar, LutheranCalendar:
+		easter = ce.easter(year, tradition)
+		christmas = fixedDate(year, time.December, 25)
+		epiphany = ce.epiphany(year, tradition)
+	case CopticCalendar, EthiopianCalendar:
+		easter = ce.easter(year, tradition)
+		christmas = nativityInYear(year)
+		epiphany = christmas.AddDate(0, 0, 12)
+	default:
+		return nil, &CalendarError{
+			Err: ErrUnsupportedCalendarTradition,
+		}
+	}
+
+	anchors := map[Anchor]time.Time{
+		AnchorEaster:     easter,
+		AnchorPalmSunday: easter.AddDate(0, 0, -7),
+		AnchorAscension:  easter.AddDate(0, 0, 39),
+		AnchorPentecost:  easter.AddDate(0, 0, 49),
+		AnchorChristmas:  christmas,
+		AnchorEpiphany:   epiphany,
+	}
+
+	switch tradition {
+	case AmbrosianCalendar:
+		anchors[AnchorTrinity] = easter.AddDate(0, 0, 56)
+		anchors[AnchorAdvent1] = ce.advent(year, tradition)
+	case CopticCalendar, EthiopianCalendar:
+	default:
+		anchors[AnchorAshWednesday] = easter.AddDate(0, 0, -46)
+		anchors[AnchorTrinity] = easter.AddDate(0, 0, 56)
+		anchors[AnchorAdvent1] = ce.advent(year, tradition)
+	}
+	return anchors, nil
+}
+
+// Anchor returns the date of a single anchor in the given year.
+func (ce *CalendarEngine) Anchor(anchor Anchor, year int, tradition CalendarTradition) (time.Time, error) {
+	anchors, err := ce.Anchors(year, tradition)
+	if err != nil {
+		return time.Time{}, err
+	}
+	date, ok := anchors[anchor]
+	if !ok {
+		return time.Time{}, &CalendarError{
+			Message: generic.Ptr("the " + string(tradition) + " tradition has no " + string(anchor) + " anchor"),
+			Err:     ErrValidationFailed,
+		}
+	}
+	return date, nil
+}
+
+// AnchorOffset is a date reckoned as a number of days from an anchor, such as "easter+7" or "pentecost-9".
+type AnchorOffset struct {
+	Anchor Anchor
+	Offset int
+}
+
+var anchorOffsetPattern = regexp.MustCompile(`^(.+?)([+-]\d+)$`)
+
+// ParseAnchorOffset parses an anchor name with an optional day offset, e.g. "ash-wednesday", "easter+3" or
+// "advent1-1".
+func ParseAnchorOffset(spec string) (AnchorOffset, error) {
+	name, offset := strings.ToLower(strings.TrimSpace(spec)), 0
+	if m := anchorOffsetPattern.FindStringSubmatch(name); m != nil {
+		name = m[1]
+		offset, _ = strconv.Atoi(m[2])
+	}
+	anchor, err := ParseAnchor(name)
+	if err != nil {
+		return AnchorOffset{}, err
+	}
+	return AnchorOffset{Anchor: anchor, Offset: offset}, nil
+}
+
+func (o AnchorOffset) String() string {
+	if o.Offset == 0 {
+		return string(o.Anchor)
+	}
+	return fmt.Sprintf("%s%+d", o.Anchor, o.Offset)
+}
+
+// AnchorOffsetDate returns the date the offset names, counted from the anchor of the given year. The date may
+// fall in another year (advent1+40, epiphany-10).
+func (ce *CalendarEngine) AnchorOffsetDate(o AnchorOffset, year int, tradition CalendarTradition) (time.Time, error) {
+	date, err := ce.Anchor(o.Anchor, year, tradition)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return date.AddDate(0, 0, o.Offset), nil
+}
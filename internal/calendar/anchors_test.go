@@ -0,0 +1,82 @@
+package calendar_test
+
+import (
+	"testing"
+
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+)
+
+func TestAnchors(t *testing.T) {
+	ce := calendar.NewCalendarEngine()
+
+	testCases := []struct {
+		tradition calendar.CalendarTradition
+		anchor    calendar.Anchor
+		expected  string
+	}{
+		{calendar.RomanCalendar, calendar.AnchorEaster, "2025-04-20"},
+		{calendar.RomanCalendar, calendar.AnchorAshWednesday, "2025-03-05"},
+		{calendar.RomanCalendar, calendar.AnchorPentecost, "2025-06-08"},
+		{calendar.RomanCalendar, calendar.AnchorAdvent1, "2025-11-30"},
+		{calendar.AmbrosianCalendar, calendar.AnchorAdvent1, "2025-11-16"},
+		{calendar.CopticCalendar, calendar.AnchorChristmas, "2025-01-07"},
+		{calendar.CopticCalendar, calendar.AnchorEpiphany, "2025-01-19"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.tradition)+":"+string(tc.anchor), func(t *testing.T) {
+			date, err := ce.Anchor(tc.anchor, 2025, tc.tradition)
+			if err != nil {
+				t.Fatalf("Anchor failed: %v", err)
+			}
+			if got := date.Format("2006-01-02"); got != tc.expected {
+				t.Errorf("Expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+
+	if _, err := ce.Anchor(calendar.AnchorAshWednesday, 2025, calendar.AmbrosianCalendar); err == nil {
+		t.Error("Expected error for Ash Wednesday in the Ambrosian rite")
+	}
+	if _, err := calendar.ParseAnchor("whitsun"); err == nil {
+		t.Error("Expected error for an unknown anchor")
+	}
+}
+
+func TestAnchorOffsets(t *testing.T) {
+	ce := calendar.NewCalendarEngine()
+
+	testCases := []struct {
+		spec     string
+		expected string
+	}{
+		{"easter", "2025-04-20"},
+		{"easter+7", "2025-04-27"},
+		{"Ash-Wednesday", "2025-03-05"},
+		{"pentecost-9", "2025-05-30"},
+		{"advent1-1", "2025-11-29"},
+		{"advent1+40", "2026-01-09"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.spec, func(t *testing.T) {
+			offset, err := calendar.ParseAnchorOffset(tc.spec)
+			if err != nil {
+				t.Fatalf("ParseAnchorOffset failed: %v", err)
+			}
+			date, err := ce.AnchorOffsetDate(offset, 2025, calendar.RomanCalendar)
+			if err != nil {
+				t.Fatalf("AnchorOffsetDate failed: %v", err)
+			}
+			if got := date.Format("2006-01-02"); got != tc.expected {
+				t.Errorf("Expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+
+	for _, spec := range []string{"easter+", "whitsun-1", "easter+three"} {
+		if _, err := calendar.ParseAnchorOffset(spec); err == nil {
+			t.Errorf("Expected error for %q", spec)
+		}
+	}
+}
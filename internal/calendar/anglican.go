@@ -0,0 +1,172 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+)
+
+// anglicanCycle returns the season transitions of the Anglican calendar (Common Worship) anchored in the given year.
+// Epiphanytide runs to the Presentation (2 February) and is followed by Ordinary Time until Ash Wednesday.
+// After Pentecost, the Sundays are numbered after Trinity until Advent.
+func (ce *CalendarEngine) anglicanCycle(year int) []seasonTransition {
+	easter := ce.easter(year, AnglicanCalendar)
+
+	return []seasonTransition{
+		transition(Epiphanytide, ce.epiphany(year, AnglicanCalendar)),
+		transition(Ordinary, fixedDate(year, time.February, 3)),
+		transition(Lent, easter.AddDate(0, 0, -46)),
+		transition(Triduum, easter.AddDate(0, 0, -3)),
+		transition(Eastertide, easter),
+		transition(Ordinary, easter.AddDate(0, 0, 50)),
+		transition(Trinitytide, easter.AddDate(0, 0, 56)),
+		transition(Advent, ce.advent(year, AnglicanCalendar)),
+		transition(Christmastide, fixedDate(year, time.December, 25)),
+	}
+}
+
+// anglicanSundayProper names the Sundays of the Anglican year that have no feast of their own,
+// e.g. "The Second Sunday of Lent" or "The Fourth Sunday after Trinity".
+func (ce *CalendarEngine) anglicanSundayProper(dayKey *DayKey, date time.Time) (string, error) {
+	switch dayKey.Season {
+	case Ordinary:
+		// Ordinary Time before Lent counts down to Ash Wednesday.
+		ashWednesday := ce.easter(date.Year(), AnglicanCalendar).AddDate(0, 0, -46)
+		if date.After(ashWednesday) {
+			return "", nil
+		}
+		n := (daysBetween(date, ashWednesday) + 4) / 7
+		if n == 1 {
+			return "The Sunday next before Lent", nil
+		}
+		return fmt.Sprintf("The %s Sunday before Lent", ordinalWord(n)), nil
+	case Trinitytide:
+		n, err := ce.sundayNumber(dayKey, date, true)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("The %s Sunday after Trinity", ordinalWord(n-1)), nil
+	}
+
+	inclusive := dayKey.Season != Christmastide && dayKey.Season != Epiphanytide
+	n, err := ce.sundayNumber(dayKey, date, inclusive)
+	if err != nil {
+		return "", err
+	}
+
+	switch dayKey.Season {
+	case Advent:
+		return fmt.Sprintf("The %s Sunday of Advent", ordinalWord(n)), nil
+	case Christmastide:
+		return fmt.Sprintf("The %s Sunday of Christmas", ordinalWord(n)), nil
+	case Epiphanytide:
+		return fmt.Sprintf("The %s Sunday of Epiphany", ordinalWord(n)), nil
+	case Lent:
+		return fmt.Sprintf("The %s Sunday of Lent", ordinalWord(n)), nil
+	case Eastertide:
+		return fmt.Sprintf("The %s Sunday of Easter", ordinalWord(n)), nil
+	default:
+		return "", nil
+	}
+}
+
+var anglicanFixedFeasts = []fixedFeast{
+	// Principal Feasts
+	{time.January, 6, feast{"The Epiphany", White, rankPrincipal}},
+	{time.February, 2, feast{"The Presentation of Christ in the Temple", White, rankPrincipal}},
+	{time.March, 25, feast{"The Annunciation of Our Lord to the Blessed Virgin Mary", White, rankPrincipal}},
+	{time.November, 1, feast{"All Saints' Day", White, rankPrincipal}},
+	{time.December, 25, feast{"Christmas Day", White, rankPrincipal}},
+	// Festivals
+	{time.January, 1, feast{"The Naming and Circumcision of Jesus", White, rankFestival}},
+	{time.January, 25, feast{"The Conversion of Paul", White, rankFestival}},
+	{time.March, 19, feast{"Joseph of Nazareth", White, rankFestival}},
+	{time.April, 25, feast{"Mark the Evangelist", Red, rankFestival}},
+	{time.May, 1, feast{"Philip and James, Apostles", Red, rankFestival}},
+	{time.May, 14, feast{"Matthias the Apostle", Red, rankFestival}},
+	{time.May, 31, feast{"The Visit of the Blessed Virgin Mary to Elizabeth", White, rankFestival}},
+	{time.June, 11, feast{"Barnabas the Apostle", Red, rankFestival}},
+	{time.June, 24, feast{"The Birth of John the Baptist", White, rankFestival}},
+	{time.June, 29, feast{"Peter and Paul, Apostles", Red, rankFestival}},
+	{time.July, 3, feast{"Thomas the Apostle", Red, rankFestival}},
+	{time.July, 22, feast{"Mary Magdalene", White, rankFestival}},
+	{time.July, 25, feast{"James the Apostle", Red, rankFestival}},
+	{time.August, 6, feast{"The Transfiguration of Our Lord", White, rankFestival}},
+	{time.August, 15, feast{"The Blessed Virgin Mary", White, rankFestival}},
+	{time.August, 24, feast{"Bartholomew the Apostle", Red, rankFestival}},
+	{time.September, 14, feast{"Holy Cross Day", Red, rankFestival}},
+	{time.September, 21, feast{"Matthew, Apostle and Evangelist", Red, rankFestival}},
+	{time.September, 29, feast{"Michael and All Angels", White, rankFestival}},
+	{time.October, 18, feast{"Luke the Evangelist", Red, rankFestival}},
+	{time.October, 28, feast{"Simon and Jude, Apostles", Red, rankFestival}},
+	{time.November, 30, feast{"Andrew the Apostle", Red, rankFestival}},
+	{time.December, 26, feast{"Stephen, Deacon, First Martyr", Red, rankFestival}},
+	{time.December, 27, feast{"John, Apostle and Evangelist", White, rankFestival}},
+	{time.December, 28, feast{"The Holy Innocents", Red, rankFestival}},
+	// Lesser Festivals
+	{time.January, 13, feast{"Hilary, Bishop of Poitiers", White, rankLesser}},
+	{time.January, 17, feast{"Antony of Egypt, Abbot", White, rankLesser}},
+	{time.January, 24, feast{"Francis de Sales, Bishop", White, rankLesser}},
+	{time.January, 28, feast{"Thomas Aquinas, Priest", White, rankLesser}},
+	{time.January, 30, feast{"Charles, King and Martyr", Red, rankLesser}},
+	{time.February, 3, feast{"Anskar, Archbishop of Hamburg", White, rankLesser}},
+	{time.February, 14, feast{"Cyril and Methodius, Missionaries", White, rankLesser}},
+	{time.February, 23, feast{"Polycarp, Bishop and Martyr", Red, rankLesser}},
+	{time.March, 1, feast{"David, Bishop of Menevia", White, rankLesser}},
+	{time.March, 2, feast{"Chad, Bishop of Lichfield", White, rankLesser}},
+	{time.March, 17, feast{"Patrick, Bishop", White, rankLesser}},
+	{time.March, 20, feast{"Cuthbert, Bishop of Lindisfarne", White, rankLesser}},
+	{time.March, 21, feast{"Thomas Cranmer, Archbishop and Martyr", Red, rankLesser}},
+	{time.April, 21, feast{"Anselm, Archbishop of Canterbury", White, rankLesser}},
+	{time.April, 29, feast{"Catherine of Siena", White, rankLesser}},
+	{time.May, 8, feast{"Julian of Norwich", White, rankLesser}},
+	{time.May, 24, feast{"John and Charles Wesley", White, rankLesser}},
+	{time.May, 25, feast{"The Venerable Bede, Monk", White, rankLesser}},
+	{time.May, 26, feast{"Augustine, First Archbishop of Canterbury", White, rankLesser}},
+	{time.June, 5, feast{"Boniface, Bishop and Martyr", Red, rankLesser}},
+	{time.June, 9, feast{"Columba, Abbot of Iona", White, rankLesser}},
+	{time.June, 22, feast{"Alban, First Martyr of Britain", Red, rankLesser}},
+	{time.July, 11, feast{"Benedict of Nursia, Abbot", White, rankLesser}},
+	{time.July, 15, feast{"Swithun, Bishop of Winchester", White, rankLesser}},
+	{time.July, 29, feast{"Mary, Martha and Lazarus", White, rankLesser}},
+	{time.July, 31, feast{"Ignatius of Loyola", White, rankLesser}},
+	{time.August, 8, feast{"Dominic, Priest", White, rankLesser}},
+	{time.August, 10, feast{"Laurence, Deacon and Martyr", Red, rankLesser}},
+	{time.August, 11, feast{"Clare of Assisi", White, rankLesser}},
+	{time.August, 20, feast{"Bernard, Abbot of Clairvaux", White, rankLesser}},
+	{time.August, 28, feast{"Augustine, Bishop of Hippo", White, rankLesser}},
+	{time.August, 31, feast{"Aidan, Bishop of Lindisfarne", White, rankLesser}},
+	{time.September, 3, feast{"Gregory the Great, Bishop of Rome", White, rankLesser}},
+	{time.September, 13, feast{"John Chrysostom, Bishop", White, rankLesser}},
+	{time.September, 30, feast{"Jerome, Translator of the Scriptures", White, rankLesser}},
+	{time.October, 4, feast{"Francis of Assisi, Friar", White, rankLesser}},
+	{time.October, 15, feast{"Teresa of Avila", White, rankLesser}},
+	{time.October, 17, feast{"Ignatius, Bishop of Antioch, Martyr", Red, rankLesser}},
+	{time.November, 2, feast{"Commemoration of the Faithful Departed", Violet, rankLesser}},
+	{time.November, 11, feast{"Martin, Bishop of Tours", White, rankLesser}},
+	{time.November, 16, feast{"Margaret, Queen of Scotland", White, rankLesser}},
+	{time.November, 17, feast{"Hugh, Bishop of Lincoln", White, rankLesser}},
+	{time.December, 6, feast{"Nicholas, Bishop of Myra", White, rankLesser}},
+	{time.December, 7, feast{"Ambrose, Bishop of Milan", White, rankLesser}},
+	{time.December, 29, feast{"Thomas Becket, Archbishop and Martyr", Red, rankLesser}},
+}
+
+var anglicanMovableFeasts = []movableFeast{
+	{-46, feast{"Ash Wednesday", Violet, rankPrincipal}},
+	{-7, feast{"Palm Sunday", Red, rankPrincipal}},
+	{-3, feast{"Maundy Thursday", White, rankPrincipal}},
+	{-2, feast{"Good Friday", Red, rankPrincipal}},
+	{-1, feast{"Easter Eve", Violet, rankPrincipal}},
+	{0, feast{"Easter Day", White, rankPrincipal}},
+	{39, feast{"Ascension Day", White, rankPrincipal}},
+	{49, feast{"Pentecost", Red, rankPrincipal}},
+	{56, feast{"Trinity Sunday", White, rankPrincipal}},
+	{60, feast{"Day of Thanksgiving for the Institution of Holy Communion", White, rankFestival}},
+}
+
+// anglicanSundayFeasts returns the Anglican observances kept on a Sunday fixed relative to a civil date.
+func anglicanSundayFeasts(ce *CalendarEngine, year int) []datedFeast {
+	return []datedFeast{
+		{ce.baptism(year, AnglicanCalendar), feast{"The Baptism of Christ", White, rankPrincipal}},
+		{ce.advent(year, AnglicanCalendar).AddDate(0, 0, -7), feast{"Christ the King", White, rankPrincipal}},
+	}
+}
@@ -0,0 +1,89 @@
+package calendar_test
+
+import (
+	"testing"
+
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+)
+
+func TestAnglicanDays(t *testing.T) {
+	ce := calendar.NewCalendarEngine()
+
+	// Easter 2025 is 20 April; Advent Sunday is 30 November.
+	testCases := []struct {
+		date        string
+		season      calendar.LiturgicalSeason
+		week        int
+		celebration string
+		colour      calendar.Colour
+	}{
+		{"2025-01-05", calendar.Christmastide, 2, "The Second Sunday of Christmas", calendar.White},
+		{"2025-01-12", calendar.Epiphanytide, 1, "The Baptism of Christ", calendar.White},
+		{"2025-01-19", calendar.Epiphanytide, 2, "The Second Sunday of Epiphany", calendar.White},
+		{"2025-02-02", calendar.Epiphanytide, 4, "The Presentation of Christ in the Temple", calendar.White},
+		{"2025-02-03", calendar.Ordinary, 1, "Anskar, Archbishop of Hamburg", calendar.White},
+		{"2025-02-09", calendar.Ordinary, 1, "The Fourth Sunday before Lent", calendar.Green},
+		{"2025-03-02", calendar.Ordinary, 4, "The Sunday next before Lent", calendar.Green},
+		{"2025-03-05", calendar.Lent, 1, "Ash Wednesday", calendar.Violet},
+		{"2025-03-30", calendar.Lent, 4, "The Fourth Sunday of Lent", calendar.Rose},
+		{"2025-04-20", calendar.Eastertide, 1, "Easter Day", calendar.White},
+		{"2025-06-15", calendar.Trinitytide, 1, "Trinity Sunday", calendar.White},
+		{"2025-06-22", calendar.Trinitytide, 2, "The First Sunday after Trinity", calendar.Green},
+		{"2025-06-24", calendar.Trinitytide, 2, "The Birth of John the Baptist", calendar.White},
+		{"2025-11-23", calendar.Trinitytide, 24, "Christ the King", calendar.White},
+		{"2025-11-30", calendar.Advent, 1, "The First Sunday of Advent", calendar.Violet},
+		{"2025-12-14", calendar.Advent, 3, "The Third Sunday of Advent", calendar.Rose},
+		// Before the Gregorian reform the fixed feasts and seasons keep their Julian dates, nine days later in 1400.
+		{"1400-01-02", calendar.Christmastide, 1, "Christmas Day", calendar.White},
+		{"1400-01-14", calendar.Epiphanytide, 1, "The Epiphany", calendar.White},
+		{"1400-02-11", calendar.Ordinary, 1, "Anskar, Archbishop of Hamburg", calendar.White},
+		{"1400-07-24", calendar.Trinitytide, 5, "Swithun, Bishop of Winchester", calendar.White},
+		{"1400-12-07", calendar.Advent, 1, "The First Sunday of Advent", calendar.Violet},
+		{"1400-12-25", calendar.Advent, 3, "", calendar.Violet},
+		{"2025-12-16", calendar.Advent, 3, "", calendar.Violet},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.date, func(t *testing.T) {
+			dayKey, err := ce.GetRomanDay(tc.date, calendar.AnglicanCalendar)
+			if err != nil {
+				t.Fatalf("GetRomanDay failed: %v", err)
+			}
+
+			if dayKey.Season != tc.season {
+				t.Errorf("Expected season %s, got %s", tc.season, dayKey.Season)
+			}
+			if dayKey.SeasonWeek != tc.week {
+				t.Errorf("Expected season week %d, got %d", tc.week, dayKey.SeasonWeek)
+			}
+			if dayKey.Celebration != tc.celebration {
+				t.Errorf("Expected celebration %q, got %q", tc.celebration, dayKey.Celebration)
+			}
+			if dayKey.Colour != tc.colour {
+				t.Errorf("Expected colour %s, got %s", tc.colour, dayKey.Colour)
+			}
+		})
+	}
+}
+
+func TestGenerateAnglicanCalendar(t *testing.T) {
+	ce := calendar.NewCalendarEngine()
+
+	for _, year := range []string{"1600", "2024", "2038"} {
+		t.Run(year, func(t *testing.T) {
+			days, err := ce.GenerateRomanCalendar(year, calendar.AnglicanCalendar)
+			if err != nil {
+				t.Fatalf("GenerateRomanCalendar failed: %v", err)
+			}
+
+			for _, day := range days {
+				if day.Weekday == calendar.Sunday && day.Celebration == "" {
+					t.Errorf("Expected a proper for Sunday %s", day.Date)
+				}
+				if day.Colour == "" {
+					t.Errorf("Expected a colour for %s", day.Date)
+				}
+			}
+		})
+	}
+}
@@ -10,24 +10,47 @@ import (
 	"github.com/julianstephens/liturgical-time-index/internal"
 )
 
-type CalendarEngine struct{}
+type CalendarEngine struct {
+	epiphanyOnSunday bool
+	overrides        []AnchorOverride
+}
 
 type CalendarTradition string
 
 const (
-	RomanCalendar CalendarTradition = "roman"
+	RomanCalendar     CalendarTradition = "roman"
+	AmbrosianCalendar CalendarTradition = "ambrosian"
+	AnglicanCalendar  CalendarTradition = "anglican"
+	LutheranCalendar  CalendarTradition = "lutheran"
+	CopticCalendar    CalendarTradition = "coptic"
+	EthiopianCalendar CalendarTradition = "ethiopian"
 )
 
+// IsSupported reports whether the engine can generate a calendar for the tradition.
+func (t CalendarTradition) IsSupported() bool {
+	switch t {
+	case RomanCalendar, AmbrosianCalendar, AnglicanCalendar, LutheranCalendar, CopticCalendar, EthiopianCalendar:
+		return true
+	default:
+		return false
+	}
+}
+
 type LiturgicalSeason string
 
 const (
-	Advent        LiturgicalSeason = "advent"
-	Christmastide LiturgicalSeason = "christmastide"
-	Epiphanytide  LiturgicalSeason = "epiphanytide"
-	Lent          LiturgicalSeason = "lent"
-	Triduum       LiturgicalSeason = "triduum"
-	Eastertide    LiturgicalSeason = "eastertide"
-	Ordinary      LiturgicalSeason = "ordinary"
+	Advent             LiturgicalSeason = "advent"
+	Christmastide      LiturgicalSeason = "christmastide"
+	Epiphanytide       LiturgicalSeason = "epiphanytide"
+	Lent               LiturgicalSeason = "lent"
+	Triduum            LiturgicalSeason = "triduum"
+	Eastertide         LiturgicalSeason = "eastertide"
+	Ordinary           LiturgicalSeason = "ordinary"
+	Trinitytide        LiturgicalSeason = "trinitytide"
+	TimeAfterPentecost LiturgicalSeason = "afterpentecost"
+	NinevehFast        LiturgicalSeason = "nineveh"
+	ApostlesFast       LiturgicalSeason = "apostles"
+	NativityFast       LiturgicalSeason = "nativityfast"
 )
 
 func (s LiturgicalSeason) String() string {
@@ -46,6 +69,16 @@ func (s LiturgicalSeason) String() string {
 		return "Eastertide"
 	case Ordinary:
 		return "Ordinary Time"
+	case Trinitytide:
+		return "Trinity"
+	case TimeAfterPentecost:
+		return "Time after Pentecost"
+	case NinevehFast:
+		return "Fast of Nineveh"
+	case ApostlesFast:
+		return "Apostles' Fast"
+	case NativityFast:
+		return "Nativity Fast"
 	default:
 		caser := cases.Title(language.English)
 		return caser.String(string(s))
@@ -86,47 +119,73 @@ func (w Weekday) String() string {
 	}
 }
 
+// DayKey identifies a single day of the liturgical year. Date is always a proleptic Gregorian date;
+// days before the Gregorian reform also carry the Julian date that was in civil use at the time, and
+// traditions with their own civil calendar (Coptic, Ethiopian) carry the native date. Celebration names the
+// feast or Sunday kept on the day, if any, and Colour is its liturgical colour.
 type DayKey struct {
-	Date       string            `json:"date"`
-	Tradition  CalendarTradition `json:"tradition"`
-	Season     LiturgicalSeason  `json:"season"      validate:"required"`
-	SeasonWeek int               `json:"season_week" validate:"required,gte=1"`
-	Weekday    Weekday           `json:"weekday"     validate:"required"`
+	Date        string            `json:"date"                  yaml:"date"`
+	JulianDate  string            `json:"julian_date,omitempty" yaml:"julian_date,omitempty"`
+	NativeDate  string            `json:"native_date,omitempty" yaml:"native_date,omitempty"`
+	Tradition   CalendarTradition `json:"tradition"             yaml:"tradition"`
+	Season      LiturgicalSeason  `json:"season"                yaml:"season"                validate:"required"`
+	SeasonWeek  int               `json:"season_week"           yaml:"season_week"           validate:"required,gte=1"`
+	Weekday     Weekday           `json:"weekday"               yaml:"weekday"               validate:"required"`
+	Celebration string            `json:"celebration,omitempty" yaml:"celebration,omitempty"`
+	Colour      Colour            `json:"colour,omitempty"      yaml:"colour,omitempty"`
 }
 
-func NewCalendarEngine() *CalendarEngine {
-	return &CalendarEngine{}
+func NewCalendarEngine(opts ...EngineOption) *CalendarEngine {
+	ce := &CalendarEngine{}
+	for _, opt := range opts {
+		opt(ce)
+	}
+	return ce
 }
 
 // GetEasterGregorian computes the date of Easter for a given year using Butcher's algorithm for the Gregorian calendar.
-// For years before 1583, it uses a simpler algorithm based on the Julian calendar.
+// For years before 1583, Easter was reckoned with the Julian computus; that date is converted so the result is
+// always a proleptic Gregorian date. Use GetEasterJulian for the date as it was kept at the time.
 func (ce *CalendarEngine) GetEasterGregorian(year int) time.Time {
-	var a, b, c, d, e, r int
-
-	a = year % 19
-	if year >= 1583 {
-		var f, g, h, i, k, l, m int
-		b = year / 100
-		c = year % 100
-		d = b / 4
-		e = b % 4
-		f = (b + 8) / 25
-		g = (b - f + 1) / 3
-		h = (19*a + b - d - g + 15) % 30
-		i = c / 4
-		k = c % 4
-		l = (32 + 2*e + 2*i - h - k) % 7
-		m = (a + 11*h + 22*l) / 451
-		r = 22 + h + l - 7*m
-	} else {
-		b = year % 7
-		c = year % 4
-		d = (19*a + 15) % 30
-		e = (2*c + 4*b - d + 34) % 7
-		r = 22 + d + e
-	}
-
-	return time.Date(year, time.March, r, 0, 0, 0, 0, time.Local)
+	if year < 1583 {
+		easter, err := JulianToGregorian(ce.GetEasterJulian(year))
+		if err != nil {
+			return time.Time{}
+		}
+		return easter
+	}
+
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	r := 22 + h + l - 7*m
+
+	return time.Date(year, time.March, r, 0, 0, 0, 0, time.UTC)
+}
+
+// GetEasterJulian computes the date of Easter for a given year using the Julian computus (Meeus' algorithm).
+// The result is a Julian calendar date.
+func (ce *CalendarEngine) GetEasterJulian(year int) JulianDate {
+	a := year % 4
+	b := year % 7
+	c := year % 19
+	d := (19*c + 15) % 30
+	e := (2*a + 4*b - d + 34) % 7
+	r := 22 + d + e
+
+	if r > 31 {
+		return JulianDate{Year: year, Month: time.April, Day: r - 31}
+	}
+	return JulianDate{Year: year, Month: time.March, Day: r}
 }
 
 // validate checks that the provided DayKey has valid values for its fields,
@@ -154,11 +213,7 @@ func (ce *CalendarEngine) validate(dayKey *DayKey) error {
 			Err:     ErrValidationFailed,
 		}
 	}
-	parsedSeason := LiturgicalSeason(dayKey.Season)
-	switch parsedSeason {
-	case Advent, Christmastide, Epiphanytide, Lent, Triduum, Eastertide, Ordinary:
-		// valid season
-	default:
+	if _, err := ParseSeason(string(dayKey.Season)); err != nil {
 		return &CalendarError{
 			Message: generic.Ptr("invalid season"),
 			Err:     ErrValidationFailed,
@@ -172,7 +227,7 @@ func (ce *CalendarEngine) validate(dayKey *DayKey) error {
 		}
 	}
 	parsedTradition := CalendarTradition(dayKey.Tradition)
-	if parsedTradition != RomanCalendar {
+	if !parsedTradition.IsSupported() {
 		return &CalendarError{
 			Message: generic.Ptr("unsupported calendar tradition"),
 			Err:     ErrUnsupportedCalendarTradition,
@@ -0,0 +1,342 @@
+package calendar
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/julianstephens/go-utils/generic"
+)
+
+// Colour is a liturgical colour, as worn in vestments and hangings for a season or celebration.
+type Colour string
+
+const (
+	White  Colour = "white"
+	Red    Colour = "red"
+	Violet Colour = "violet"
+	Rose   Colour = "rose"
+	Green  Colour = "green"
+	Blue   Colour = "blue"
+	Black  Colour = "black"
+)
+
+// rank orders competing celebrations on the same day; lower ranks take precedence.
+// Sunday propers outrank festivals and lesser festivals but yield to principal feasts.
+type rank int
+
+const (
+	rankPrincipal rank = iota + 1
+	rankSunday
+	rankFestival
+	rankLesser
+)
+
+type feast struct {
+	Name   string
+	Colour Colour
+	rank   rank
+}
+
+// fixedFeast is a celebration kept on the same civil date every year.
+type fixedFeast struct {
+	Month time.Month
+	Day   int
+	feast
+}
+
+// movableFeast is a celebration kept a number of days before or after Easter.
+type movableFeast struct {
+	Offset int
+	feast
+}
+
+// datedFeast is a celebration resolved to a date in a particular year.
+type datedFeast struct {
+	Date time.Time
+	feast
+}
+
+// celebrate resolves the principal celebration and liturgical colour of a day and stores them on the DayKey.
+func (ce *CalendarEngine) celebrate(dayKey *DayKey, date time.Time) error {
+	feasts, err := ce.feasts(date.Year(), dayKey.Tradition)
+	if err != nil {
+		return err
+	}
+	if IsPreReform(date) && date.Month() == time.January {
+		// The feasts of the last days of the Julian December fall in the Gregorian January that follows.
+		previous, err := ce.feasts(date.Year()-1, dayKey.Tradition)
+		if err != nil {
+			return err
+		}
+		feasts = append(feasts, previous...)
+	}
+
+	var chosen *feast
+	for i := range feasts {
+		if !feasts[i].Date.Equal(date) {
+			continue
+		}
+		if chosen == nil || feasts[i].rank < chosen.rank {
+			chosen = &feasts[i].feast
+		}
+	}
+
+	if date.Weekday() == time.Sunday && (chosen == nil || chosen.rank > rankSunday) {
+		name, err := ce.sundayProper(dayKey, date)
+		if err != nil {
+			return err
+		}
+		if name != "" {
+			chosen = &feast{Name: name, rank: rankSunday}
+		}
+	}
+
+	colour, err := ce.seasonColour(dayKey, date)
+	if err != nil {
+		return err
+	}
+	if chosen != nil {
+		dayKey.Celebration = chosen.Name
+		if chosen.Colour != "" {
+			colour = chosen.Colour
+		}
+	}
+	dayKey.Colour = colour
+
+	return nil
+}
+
+// feasts returns the fixed and movable celebrations of a tradition resolved to dates in the given year.
+func (ce *CalendarEngine) feasts(year int, tradition CalendarTradition) ([]datedFeast, error) {
+	if tradition == CopticCalendar || tradition == EthiopianCalendar {
+		return ce.alexandrianFeasts(year, tradition), nil
+	}
+
+	fixed, err := ce.fixedFeasts(year, tradition)
+	if err != nil {
+		return nil, err
+	}
+	movable, extra, err := ce.movableFeasts(year, tradition)
+	if err != nil {
+		return nil, err
+	}
+	return append(append(fixed, movable...), extra...), nil
+}
+
+// fixedFeasts returns the celebrations of a tradition that are kept on the same calendar date every year,
+// resolved to dates in the given year.
+func (ce *CalendarEngine) fixedFeasts(year int, tradition CalendarTradition) ([]datedFeast, error) {
+	var fixed []fixedFeast
+	switch tradition {
+	case RomanCalendar:
+		fixed = romanFixedFeasts
+	case AmbrosianCalendar:
+		fixed = ambrosianFixedFeasts
+	case AnglicanCalendar:
+		fixed = anglicanFixedFeasts
+	case LutheranCalendar:
+		fixed = lutheranFixedFeasts
+	case CopticCalendar, EthiopianCalendar:
+		return generic.Filter(ce.alexandrianFeasts(year, tradition), func(f datedFeast) bool {
+			return slices.Contains(alexandrianFixedFeasts, f.Name)
+		}), nil
+	default:
+		return nil, &CalendarError{
+			Err: ErrUnsupportedCalendarTradition,
+		}
+	}
+
+	result := make([]datedFeast, 0, len(fixed))
+	for _, f := range fixed {
+		date := fixedDate(year, f.Month, f.Day)
+		if f.Month == time.January && f.Day == 6 {
+			// The Epiphany may be transferred to a Sunday.
+			date = ce.epiphany(year, tradition)
+		}
+		result = append(result, datedFeast{Date: date, feast: f.feast})
+	}
+	return result, nil
+}
+
+// movableFeasts returns the celebrations of a Western tradition that are reckoned from Easter, followed by
+// those kept on a Sunday fixed relative to a civil date.
+func (ce *CalendarEngine) movableFeasts(year int, tradition CalendarTradition) ([]datedFeast, []datedFeast, error) {
+	var movable []movableFeast
+	var extra []datedFeast
+	switch tradition {
+	case RomanCalendar:
+		movable, extra = romanMovableFeasts, romanSundayFeasts(ce, year)
+	case AmbrosianCalendar:
+		movable, extra = ambrosianMovableFeasts, ambrosianSundayFeasts(ce, year)
+	case AnglicanCalendar:
+		movable, extra = anglicanMovableFeasts, anglicanSundayFeasts(ce, year)
+	case LutheranCalendar:
+		movable, extra = lutheranMovableFeasts, lutheranSundayFeasts(ce, year)
+	default:
+		return nil, nil, &CalendarError{
+			Err: ErrUnsupportedCalendarTradition,
+		}
+	}
+
+	easter := ce.easter(year, tradition)
+	result := make([]datedFeast, 0, len(movable))
+	for _, f := range movable {
+		result = append(result, datedFeast{Date: easter.AddDate(0, 0, f.Offset), feast: f.feast})
+	}
+	return result, extra, nil
+}
+
+// seasonColour returns the colour of the season on the given date for traditions that use liturgical colours.
+func (ce *CalendarEngine) seasonColour(dayKey *DayKey, date time.Time) (Colour, error) {
+	switch dayKey.Tradition {
+	case CopticCalendar, EthiopianCalendar:
+		return "", nil
+	}
+
+	switch dayKey.Season {
+	case Advent:
+		if dayKey.Tradition == LutheranCalendar {
+			return Blue, nil
+		}
+		// The six-week Ambrosian Advent has no Gaudete Sunday.
+		if dayKey.Tradition != AmbrosianCalendar && date.Weekday() == time.Sunday && dayKey.SeasonWeek == 3 {
+			// Gaudete Sunday
+			return Rose, nil
+		}
+		return Violet, nil
+	case Lent:
+		if dayKey.Tradition != LutheranCalendar && dayKey.Tradition != AmbrosianCalendar &&
+			date.Weekday() == time.Sunday {
+			n, err := ce.sundayNumber(dayKey, date, true)
+			if err != nil {
+				return "", err
+			}
+			if n == 4 {
+				// Laetare Sunday
+				return Rose, nil
+			}
+		}
+		return Violet, nil
+	case Christmastide, Triduum, Eastertide:
+		return White, nil
+	case Epiphanytide:
+		switch dayKey.Tradition {
+		case AnglicanCalendar:
+			return White, nil
+		case RomanCalendar, AmbrosianCalendar:
+			// The Christmas season proper ends with the Baptism of the Lord.
+			if !date.After(ce.baptism(date.Year(), dayKey.Tradition)) {
+				return White, nil
+			}
+		}
+		return Green, nil
+	default:
+		return Green, nil
+	}
+}
+
+// sundayProper names a Sunday that has no feast of its own, for traditions whose propers are numbered by season.
+func (ce *CalendarEngine) sundayProper(dayKey *DayKey, date time.Time) (string, error) {
+	switch dayKey.Tradition {
+	case AnglicanCalendar:
+		return ce.anglicanSundayProper(dayKey, date)
+	case LutheranCalendar:
+		return ce.lutheranSundayProper(dayKey, date)
+	default:
+		return "", nil
+	}
+}
+
+// sundayNumber returns the ordinal of a Sunday within its season. When inclusive is false, a season that begins
+// on a Sunday (Christmas Day, the Epiphany) does not count that day as its first Sunday.
+func (ce *CalendarEngine) sundayNumber(dayKey *DayKey, date time.Time, inclusive bool) (int, error) {
+	origin, err := ce.getRomanSeasonStartDate(dayKey.Date, dayKey.Season, dayKey.Tradition)
+	if err != nil {
+		return 0, err
+	}
+	first := sundayAfter(origin)
+	if inclusive && origin.Weekday() == time.Sunday {
+		first = origin
+	}
+	return daysBetween(first, date)/7 + 1, nil
+}
+
+// sundayAfter returns the first Sunday strictly after the given date.
+func sundayAfter(date time.Time) time.Time {
+	return date.AddDate(0, 0, 7-int(date.Weekday()))
+}
+
+// sundayOnOrAfter returns the given date if it is a Sunday, otherwise the following Sunday.
+func sundayOnOrAfter(date time.Time) time.Time {
+	return date.AddDate(0, 0, (7-int(date.Weekday()))%7)
+}
+
+func daysBetween(from, to time.Time) int {
+	return int(to.Sub(from).Hours() / 24)
+}
+
+var ordinalWords = []string{
+	"First", "Second", "Third", "Fourth", "Fifth", "Sixth", "Seventh", "Eighth", "Ninth", "Tenth",
+	"Eleventh", "Twelfth", "Thirteenth", "Fourteenth", "Fifteenth", "Sixteenth", "Seventeenth", "Eighteenth",
+	"Nineteenth", "Twentieth", "Twenty-first", "Twenty-second", "Twenty-third", "Twenty-fourth", "Twenty-fifth",
+	"Twenty-sixth", "Twenty-seventh", "Twenty-eighth",
+}
+
+// ordinalWord spells out an ordinal as used in the names of Sundays ("Third", "Twenty-first").
+func ordinalWord(n int) string {
+	if n >= 1 && n <= len(ordinalWords) {
+		return ordinalWords[n-1]
+	}
+	return fmt.Sprintf("%dth", n)
+}
+
+var romanFixedFeasts = []fixedFeast{
+	{time.January, 1, feast{"Mary, Mother of God", White, rankPrincipal}},
+	{time.January, 6, feast{"The Epiphany of the Lord", White, rankPrincipal}},
+	{time.February, 2, feast{"The Presentation of the Lord", White, rankFestival}},
+	{time.March, 19, feast{"Saint Joseph", White, rankPrincipal}},
+	{time.March, 25, feast{"The Annunciation of the Lord", White, rankPrincipal}},
+	{time.June, 24, feast{"The Nativity of Saint John the Baptist", White, rankPrincipal}},
+	{time.June, 29, feast{"Saints Peter and Paul", Red, rankPrincipal}},
+	{time.July, 11, feast{"Saint Benedict", White, rankFestival}},
+	{time.August, 6, feast{"The Transfiguration of the Lord", White, rankFestival}},
+	{time.August, 15, feast{"The Assumption of the Blessed Virgin Mary", White, rankPrincipal}},
+	{time.September, 14, feast{"The Exaltation of the Holy Cross", Red, rankFestival}},
+	{time.November, 1, feast{"All Saints", White, rankPrincipal}},
+	{time.November, 2, feast{"All Souls", Violet, rankFestival}},
+	{time.December, 8, feast{"The Immaculate Conception", White, rankPrincipal}},
+	{time.December, 25, feast{"The Nativity of the Lord", White, rankPrincipal}},
+	{time.December, 26, feast{"Saint Stephen", Red, rankFestival}},
+	{time.December, 27, feast{"Saint John", White, rankFestival}},
+	{time.December, 28, feast{"The Holy Innocents", Red, rankFestival}},
+}
+
+var romanMovableFeasts = []movableFeast{
+	{-46, feast{"Ash Wednesday", Violet, rankPrincipal}},
+	{-7, feast{"Palm Sunday", Red, rankPrincipal}},
+	{-3, feast{"Holy Thursday", White, rankPrincipal}},
+	{-2, feast{"Good Friday", Red, rankPrincipal}},
+	{-1, feast{"Holy Saturday", Violet, rankPrincipal}},
+	{0, feast{"Easter Sunday", White, rankPrincipal}},
+	{39, feast{"The Ascension of the Lord", White, rankPrincipal}},
+	{49, feast{"Pentecost", Red, rankPrincipal}},
+	{56, feast{"The Most Holy Trinity", White, rankPrincipal}},
+	{60, feast{"The Most Holy Body and Blood of Christ", White, rankPrincipal}},
+}
+
+// romanSundayFeasts returns the Roman feasts that are kept on a Sunday fixed relative to a civil date.
+func romanSundayFeasts(ce *CalendarEngine, year int) []datedFeast {
+	// The Holy Family is kept on the Sunday within the Christmas octave, or on 30 December if there is none.
+	christmas := fixedDate(year, time.December, 25)
+	holyFamily := sundayAfter(christmas)
+	if christmas.Weekday() == time.Sunday {
+		holyFamily = christmas.AddDate(0, 0, 5)
+	}
+
+	return []datedFeast{
+		{ce.baptism(year, RomanCalendar), feast{"The Baptism of the Lord", White, rankPrincipal}},
+		{ce.advent(year, RomanCalendar).AddDate(0, 0, -7), feast{"Christ the King", White, rankPrincipal}},
+		{holyFamily, feast{"The Holy Family", White, rankPrincipal}},
+	}
+}
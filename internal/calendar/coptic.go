@@ -0,0 +1,223 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/julianstephens/go-utils/generic"
+)
+
+var (
+	copticEpoch    = julianToJDN(284, time.August, 29)
+	ethiopianEpoch = julianToJDN(8, time.August, 29)
+
+	copticMonths = []string{
+		"Thout", "Paopi", "Hathor", "Koiak", "Tobi", "Meshir", "Paremhat",
+		"Parmouti", "Pashons", "Paoni", "Epip", "Mesori", "Nasie",
+	}
+	ethiopianMonths = []string{
+		"Meskerem", "Tikimt", "Hidar", "Tahsas", "Tir", "Yekatit", "Megabit",
+		"Miyazya", "Ginbot", "Sene", "Hamle", "Nehasse", "Pagume",
+	}
+)
+
+// NativeDate is a date in the 13-month civil calendar of the Coptic or Ethiopian tradition:
+// twelve months of 30 days followed by an epagomenal month of 5 days (6 in leap years).
+type NativeDate struct {
+	Calendar CalendarTradition
+	Year     int
+	Month    int
+	Day      int
+}
+
+// String formats the native date with its month name and era, e.g. "8 Thout 1741 AM" or "8 Meskerem 2017 EC".
+func (d NativeDate) String() string {
+	months, era := copticMonths, "AM"
+	if d.Calendar == EthiopianCalendar {
+		months, era = ethiopianMonths, "EC"
+	}
+	if d.Month < 1 || d.Month > len(months) {
+		return fmt.Sprintf("%d-%d-%d %s", d.Year, d.Month, d.Day, era)
+	}
+	return fmt.Sprintf("%d %s %d %s", d.Day, months[d.Month-1], d.Year, era)
+}
+
+// GregorianToNative converts a proleptic Gregorian date to the Coptic or Ethiopian civil calendar.
+func GregorianToNative(t time.Time, tradition CalendarTradition) (NativeDate, error) {
+	epoch, err := nativeEpoch(tradition)
+	if err != nil {
+		return NativeDate{}, err
+	}
+
+	jdn := gregorianToJDN(t.Year(), t.Month(), t.Day())
+	year := (4*(jdn-epoch) + 1463) / 1461
+	month := 1 + (jdn-nativeToJDN(epoch, year, 1, 1))/30
+	day := jdn + 1 - nativeToJDN(epoch, year, month, 1)
+
+	return NativeDate{Calendar: tradition, Year: year, Month: month, Day: day}, nil
+}
+
+// NativeToGregorian converts a Coptic or Ethiopian civil date to the proleptic Gregorian calendar.
+func NativeToGregorian(d NativeDate) (time.Time, error) {
+	epoch, err := nativeEpoch(d.Calendar)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	monthLength := 30
+	if d.Month == 13 {
+		monthLength = 5
+		if d.Year%4 == 3 {
+			monthLength = 6
+		}
+	}
+	if d.Year < 1 || d.Month < 1 || d.Month > 13 || d.Day < 1 || d.Day > monthLength {
+		return time.Time{}, &CalendarError{
+			Message: generic.Ptr("invalid native date: " + d.String()),
+			Err:     ErrValidationFailed,
+		}
+	}
+
+	return dateFromJDN(nativeToJDN(epoch, d.Year, d.Month, d.Day)), nil
+}
+
+func nativeEpoch(tradition CalendarTradition) (int, error) {
+	switch tradition {
+	case CopticCalendar:
+		return copticEpoch, nil
+	case EthiopianCalendar:
+		return ethiopianEpoch, nil
+	default:
+		return 0, &CalendarError{
+			Message: generic.Ptr("tradition has no native calendar: " + string(tradition)),
+			Err:     ErrUnsupportedCalendarTradition,
+		}
+	}
+}
+
+func nativeToJDN(epoch, year, month, day int) int {
+	return epoch - 1 + 365*(year-1) + year/4 + 30*(month-1) + day
+}
+
+// GetEasterAlexandrian returns the Easter kept by the Coptic and Ethiopian churches: the Julian computus
+// (Alexandrian reckoning) expressed as a proleptic Gregorian date.
+func (ce *CalendarEngine) GetEasterAlexandrian(year int) time.Time {
+	easter, err := JulianToGregorian(ce.GetEasterJulian(year))
+	if err != nil {
+		return time.Time{}
+	}
+	return easter
+}
+
+// alexandrianNativity returns the Nativity kept on 25 December in the Julian calendar of the given year
+// (29 Koiak / 29 Tahsas, currently 7 January of the following Gregorian year).
+func alexandrianNativity(year int) time.Time {
+	nativity, _ := JulianToGregorian(JulianDate{Year: year, Month: time.December, Day: 25})
+	return nativity
+}
+
+// nativityInYear returns the Alexandrian Nativity that falls in the given Gregorian year. Before the 16th century
+// the Julian and Gregorian calendars differed by less than a week, so the feast still fell in December.
+func nativityInYear(year int) time.Time {
+	nativity := alexandrianNativity(year - 1)
+	if nativity.Year() != year {
+		return alexandrianNativity(year)
+	}
+	return nativity
+}
+
+// alexandrianCycle returns the season transitions of the Coptic and Ethiopian churches anchored in the given
+// Gregorian year: the fasts and seasons that depend on that year's Easter, followed by the Nativity Fast and
+// Nativity that close the civil year.
+func (ce *CalendarEngine) alexandrianCycle(year int) []seasonTransition {
+	easter := ce.easter(year, CopticCalendar)
+	theophany := alexandrianNativity(year-1).AddDate(0, 0, 12)
+	apostlesFeast, _ := JulianToGregorian(JulianDate{Year: year, Month: time.June, Day: 29})
+	nativity := alexandrianNativity(year)
+
+	transitions := []seasonTransition{
+		transition(Epiphanytide, theophany),
+		// The three-day Fast of Nineveh begins on the Monday two weeks before Great Lent.
+		transition(NinevehFast, easter.AddDate(0, 0, -69)),
+		{Season: Epiphanytide, From: easter.AddDate(0, 0, -66), Origin: theophany},
+		// Great Lent is 55 days, including the preparation week and Holy Week.
+		transition(Lent, easter.AddDate(0, 0, -55)),
+		// The Holy Fifty Days run from Easter through Pentecost.
+		transition(Eastertide, easter),
+	}
+
+	// The Apostles' Fast begins the day after Pentecost and ends on the eve of Sts Peter and Paul.
+	apostlesFast := easter.AddDate(0, 0, 50)
+	if apostlesFast.Before(apostlesFeast) {
+		transitions = append(transitions, transition(ApostlesFast, apostlesFast))
+	}
+
+	return append(transitions,
+		transition(Ordinary, apostlesFeast),
+		// The Nativity Fast is kept for the 43 days before the Nativity.
+		transition(NativityFast, nativity.AddDate(0, 0, -43)),
+		transition(Christmastide, nativity),
+	)
+}
+
+// alexandrianHolidays returns the principal feasts and fasts of the Coptic and Ethiopian churches for a year.
+func (ce *CalendarEngine) alexandrianHolidays(year int) ([]string, []time.Time) {
+	easter := ce.easter(year, CopticCalendar)
+	nativity := nativityInYear(year)
+	apostlesFeast, _ := JulianToGregorian(JulianDate{Year: year, Month: time.June, Day: 29})
+
+	names := []string{
+		"Nativity",
+		"Theophany",
+		"Fast of Nineveh",
+		"Great Lent",
+		"Palm Sunday",
+		"Good Friday",
+		"Easter Sunday",
+		"Pentecost",
+		"Apostles' Feast",
+	}
+	dates := []time.Time{
+		nativity,
+		nativity.AddDate(0, 0, 12),
+		easter.AddDate(0, 0, -69),
+		easter.AddDate(0, 0, -55),
+		easter.AddDate(0, 0, -7),
+		easter.AddDate(0, 0, -2),
+		easter,
+		easter.AddDate(0, 0, 49),
+		apostlesFeast,
+	}
+	return names, dates
+}
+
+// alexandrianFixedFeasts names the Coptic and Ethiopian celebrations kept on a fixed date of the Julian or
+// native calendar rather than reckoned from Easter.
+var alexandrianFixedFeasts = []string{"Nativity", "Theophany", "Apostles' Feast", "Nayrouz", "Enkutatash"}
+
+// alexandrianFeasts returns the celebrations of the Coptic and Ethiopian churches that fall in a Gregorian year:
+// the principal feasts and fasts together with the civil new year (Nayrouz, Enkutatash). These traditions do not
+// use the Western sequence of liturgical colours, so the feasts carry none.
+func (ce *CalendarEngine) alexandrianFeasts(year int, tradition CalendarTradition) []datedFeast {
+	names, dates := ce.alexandrianHolidays(year)
+
+	feasts := make([]datedFeast, 0, len(names)+1)
+	for i, name := range names {
+		feasts = append(feasts, datedFeast{Date: dates[i], feast: feast{Name: name, rank: rankPrincipal}})
+	}
+
+	name := "Nayrouz"
+	if tradition == EthiopianCalendar {
+		name = "Enkutatash"
+	}
+	// The native year that is running on 31 December began earlier in the same Gregorian year.
+	native, err := GregorianToNative(civilDate(year, time.December, 31), tradition)
+	if err != nil {
+		return feasts
+	}
+	newYear, err := NativeToGregorian(NativeDate{Calendar: tradition, Year: native.Year, Month: 1, Day: 1})
+	if err != nil {
+		return feasts
+	}
+	return append(feasts, datedFeast{Date: newYear, feast: feast{Name: name, rank: rankFestival}})
+}
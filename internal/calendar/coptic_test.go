@@ -0,0 +1,166 @@
+package calendar_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+)
+
+func TestGregorianToNative(t *testing.T) {
+	testCases := []struct {
+		date      string
+		tradition calendar.CalendarTradition
+		year      int
+		month     int
+		day       int
+		formatted string
+	}{
+		{"2024-09-11", calendar.CopticCalendar, 1741, 1, 1, "1 Thout 1741 AM"},
+		{"2023-09-12", calendar.CopticCalendar, 1740, 1, 1, "1 Thout 1740 AM"},
+		{"2023-09-11", calendar.CopticCalendar, 1739, 13, 6, "6 Nasie 1739 AM"},
+		{"2025-01-07", calendar.CopticCalendar, 1741, 4, 29, "29 Koiak 1741 AM"},
+		{"2024-09-11", calendar.EthiopianCalendar, 2017, 1, 1, "1 Meskerem 2017 EC"},
+		{"2025-01-07", calendar.EthiopianCalendar, 2017, 4, 29, "29 Tahsas 2017 EC"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.tradition)+":"+tc.date, func(t *testing.T) {
+			parsed, _ := time.Parse("2006-01-02", tc.date)
+
+			native, err := calendar.GregorianToNative(parsed, tc.tradition)
+			if err != nil {
+				t.Fatalf("GregorianToNative failed: %v", err)
+			}
+			expected := calendar.NativeDate{Calendar: tc.tradition, Year: tc.year, Month: tc.month, Day: tc.day}
+			if native != expected {
+				t.Errorf("Expected %+v, got %+v", expected, native)
+			}
+			if native.String() != tc.formatted {
+				t.Errorf("Expected %q, got %q", tc.formatted, native.String())
+			}
+
+			gregorian, err := calendar.NativeToGregorian(native)
+			if err != nil {
+				t.Fatalf("NativeToGregorian failed: %v", err)
+			}
+			if !gregorian.Equal(parsed) {
+				t.Errorf("Expected round trip to %s, got %s", tc.date, gregorian.Format("2006-01-02"))
+			}
+		})
+	}
+}
+
+func TestNativeToGregorianInvalidDate(t *testing.T) {
+	// 1740 is not a Coptic leap year, so Nasie only has 5 days.
+	nasie := calendar.NativeDate{Calendar: calendar.CopticCalendar, Year: 1740, Month: 13, Day: 6}
+	if _, err := calendar.NativeToGregorian(nasie); err == nil {
+		t.Error("Expected error for 6 Nasie in a common year")
+	}
+
+	roman := calendar.NativeDate{Calendar: calendar.RomanCalendar, Year: 1740, Month: 1, Day: 1}
+	_, err := calendar.NativeToGregorian(roman)
+	if err == nil {
+		t.Error("Expected error for a tradition without a native calendar")
+	}
+}
+
+func TestCopticSeasons(t *testing.T) {
+	ce := calendar.NewCalendarEngine()
+
+	// Coptic Easter 2025 is 20 April, which coincides with the Gregorian Easter.
+	testCases := []struct {
+		date   string
+		season calendar.LiturgicalSeason
+		week   int
+	}{
+		{"2025-01-06", calendar.NativityFast, 7},
+		{"2025-01-07", calendar.Christmastide, 1},
+		{"2025-01-19", calendar.Epiphanytide, 1},
+		{"2025-02-10", calendar.NinevehFast, 1},
+		{"2025-02-12", calendar.NinevehFast, 1},
+		{"2025-02-13", calendar.Epiphanytide, 4},
+		{"2025-02-24", calendar.Lent, 1},
+		{"2025-04-19", calendar.Lent, 8},
+		{"2025-04-20", calendar.Eastertide, 1},
+		{"2025-06-08", calendar.Eastertide, 8},
+		{"2025-06-09", calendar.ApostlesFast, 1},
+		{"2025-07-11", calendar.ApostlesFast, 5},
+		{"2025-07-12", calendar.Ordinary, 1},
+		{"2025-11-25", calendar.NativityFast, 1},
+	}
+
+	for _, tradition := range []calendar.CalendarTradition{calendar.CopticCalendar, calendar.EthiopianCalendar} {
+		for _, tc := range testCases {
+			t.Run(string(tradition)+":"+tc.date, func(t *testing.T) {
+				dayKey, err := ce.GetRomanDay(tc.date, tradition)
+				if err != nil {
+					t.Fatalf("GetRomanDay failed: %v", err)
+				}
+
+				if dayKey.Season != tc.season {
+					t.Errorf("Expected season %s, got %s", tc.season, dayKey.Season)
+				}
+				if dayKey.SeasonWeek != tc.week {
+					t.Errorf("Expected season week %d, got %d", tc.week, dayKey.SeasonWeek)
+				}
+				if dayKey.NativeDate == "" {
+					t.Error("Expected a native date")
+				}
+			})
+		}
+	}
+}
+
+func TestGenerateCopticCalendar(t *testing.T) {
+	ce := calendar.NewCalendarEngine()
+
+	for _, year := range []string{"1400", "2024", "2025", "2100"} {
+		t.Run(year, func(t *testing.T) {
+			days, err := ce.GenerateRomanCalendar(year, calendar.CopticCalendar)
+			if err != nil {
+				t.Fatalf("GenerateRomanCalendar failed: %v", err)
+			}
+
+			seen := map[calendar.LiturgicalSeason]bool{}
+			for _, day := range days {
+				seen[day.Season] = true
+			}
+			for _, season := range []calendar.LiturgicalSeason{
+				calendar.NinevehFast, calendar.Lent, calendar.ApostlesFast, calendar.NativityFast,
+			} {
+				if !seen[season] {
+					t.Errorf("Expected %s in %s", season, year)
+				}
+			}
+		})
+	}
+}
+
+func TestCopticHolidays(t *testing.T) {
+	ce := calendar.NewCalendarEngine()
+
+	holidays, err := ce.Holidays(2024, calendar.CopticCalendar)
+	if err != nil {
+		t.Fatalf("Holidays failed: %v", err)
+	}
+
+	// In 2024 the Coptic Easter (5 May) fell five weeks after the Gregorian Easter.
+	expected := map[string]string{
+		"Nativity":        "2024-01-07",
+		"Theophany":       "2024-01-19",
+		"Easter Sunday":   "2024-05-05",
+		"Pentecost":       "2024-06-23",
+		"Apostles' Feast": "2024-07-12",
+	}
+	for name, date := range expected {
+		holiday, ok := holidays[name]
+		if !ok {
+			t.Errorf("Missing expected holiday: %s", name)
+			continue
+		}
+		if holiday.Date != date {
+			t.Errorf("Expected %s on %s, got %s", name, date, holiday.Date)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package calendar
+
+// sundayCycles are the Sunday lectionary cycles, indexed by the remainder of the liturgical year divided by 3.
+var sundayCycles = []string{"C", "A", "B"}
+
+// LiturgicalYear returns the number of the liturgical year the day belongs to: the civil year in which it ends, so
+// that the year beginning on the First Sunday of Advent 2024 is 2025.
+func (ce *CalendarEngine) LiturgicalYear(day DayKey) (int, error) {
+	date, err := parseSupportedDate(day.Date)
+	if err != nil {
+		return 0, err
+	}
+	if date.Before(ce.advent(date.Year(), day.Tradition)) {
+		return date.Year(), nil
+	}
+	return date.Year() + 1, nil
+}
+
+// LectionaryCycle returns the Sunday cycle (A, B or C) and the weekday cycle (1 or 2) of the liturgical year the
+// day belongs to. A year divisible by 3 reads Year C on Sundays, and an odd year reads the first weekday cycle.
+func (ce *CalendarEngine) LectionaryCycle(day DayKey) (string, int, error) {
+	year, err := ce.LiturgicalYear(day)
+	if err != nil {
+		return "", 0, err
+	}
+	return sundayCycles[year%3], 2 - year%2, nil
+}
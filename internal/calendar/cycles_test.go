@@ -0,0 +1,45 @@
+package calendar_test
+
+import (
+	"testing"
+
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+)
+
+func TestLectionaryCycle(t *testing.T) {
+	testCases := []struct {
+		date           string
+		tradition      calendar.CalendarTradition
+		expectedYear   int
+		expectedSunday string
+		expectedCycle  int
+	}{
+		{"2024-11-30", calendar.RomanCalendar, 2024, "B", 2},
+		{"2024-12-01", calendar.RomanCalendar, 2025, "C", 1},
+		{"2025-06-15", calendar.RomanCalendar, 2025, "C", 1},
+		{"2025-11-30", calendar.RomanCalendar, 2026, "A", 2},
+		{"2026-11-29", calendar.AnglicanCalendar, 2027, "B", 1},
+		{"2025-11-16", calendar.AmbrosianCalendar, 2026, "A", 2},
+	}
+
+	ce := calendar.NewCalendarEngine()
+	for _, tc := range testCases {
+		t.Run(tc.date, func(t *testing.T) {
+			day := calendar.DayKey{Date: tc.date, Tradition: tc.tradition}
+			year, err := ce.LiturgicalYear(day)
+			if err != nil {
+				t.Fatalf("LiturgicalYear failed: %v", err)
+			}
+			if year != tc.expectedYear {
+				t.Errorf("Expected liturgical year %d, got %d", tc.expectedYear, year)
+			}
+			sunday, weekday, err := ce.LectionaryCycle(day)
+			if err != nil {
+				t.Fatalf("LectionaryCycle failed: %v", err)
+			}
+			if sunday != tc.expectedSunday || weekday != tc.expectedCycle {
+				t.Errorf("Expected cycles %s/%d, got %s/%d", tc.expectedSunday, tc.expectedCycle, sunday, weekday)
+			}
+		})
+	}
+}
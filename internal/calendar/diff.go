@@ -0,0 +1,49 @@
+package calendar
+
+// DayDiff records a date on which two calendars disagree, and the fields that differ.
+type DayDiff struct {
+	Date   string   `json:"date"`
+	Left   DayKey   `json:"left"`
+	Right  DayKey   `json:"right"`
+	Fields []string `json:"fields"`
+}
+
+// Diff lines up two calendars date by date and returns the days whose season, season week or celebration differ.
+// Dates present in only one of the calendars are ignored.
+func Diff(left, right []DayKey) []DayDiff {
+	rightByDate := make(map[string]DayKey, len(right))
+	for _, day := range right {
+		rightByDate[day.Date] = day
+	}
+
+	diffs := []DayDiff{}
+	for _, l := range left {
+		r, ok := rightByDate[l.Date]
+		if !ok || diffKey(l) == diffKey(r) {
+			continue
+		}
+
+		var fields []string
+		if l.Season != r.Season {
+			fields = append(fields, "season")
+		}
+		if l.SeasonWeek != r.SeasonWeek {
+			fields = append(fields, "season_week")
+		}
+		if l.Celebration != r.Celebration {
+			fields = append(fields, "celebration")
+		}
+		diffs = append(diffs, DayDiff{Date: l.Date, Left: l, Right: r, Fields: fields})
+	}
+	return diffs
+}
+
+// diffKey reduces a DayKey to the fields compared by Diff, so that two days can be compared with ==.
+func diffKey(day DayKey) DayKey {
+	return DayKey{
+		Date:        day.Date,
+		Season:      day.Season,
+		SeasonWeek:  day.SeasonWeek,
+		Celebration: day.Celebration,
+	}
+}
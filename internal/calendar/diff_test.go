@@ -0,0 +1,77 @@
+package calendar_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+)
+
+func TestDiffTraditions(t *testing.T) {
+	ce := calendar.NewCalendarEngine()
+
+	roman, err := ce.GenerateCalendarRange("2024-03-24", "2024-05-12", calendar.RomanCalendar)
+	if err != nil {
+		t.Fatalf("GenerateCalendarRange failed: %v", err)
+	}
+	coptic, err := ce.GenerateCalendarRange("2024-03-24", "2024-05-12", calendar.CopticCalendar)
+	if err != nil {
+		t.Fatalf("GenerateCalendarRange failed: %v", err)
+	}
+
+	diffs := calendar.Diff(roman, coptic)
+	if len(diffs) == 0 {
+		t.Fatal("Expected differences between the Roman and Coptic calendars in Eastertide 2024")
+	}
+
+	// The Roman Easter (31 March) fell during the Coptic Great Lent.
+	i := slices.IndexFunc(diffs, func(d calendar.DayDiff) bool { return d.Date == "2024-03-31" })
+	if i < 0 {
+		t.Fatal("Expected a difference on 2024-03-31")
+	}
+	if diffs[i].Left.Season != calendar.Eastertide || diffs[i].Right.Season != calendar.Lent {
+		t.Errorf("Expected Eastertide vs Lent, got %s vs %s", diffs[i].Left.Season, diffs[i].Right.Season)
+	}
+	if !slices.Contains(diffs[i].Fields, "season") {
+		t.Errorf("Expected season in differing fields, got %v", diffs[i].Fields)
+	}
+}
+
+func TestDiffEngineOptions(t *testing.T) {
+	option, err := calendar.ParseEngineOption("epiphany-sunday")
+	if err != nil {
+		t.Fatalf("ParseEngineOption failed: %v", err)
+	}
+	if _, err := calendar.ParseEngineOption("no-such-option"); err == nil {
+		t.Error("Expected error for an unknown engine option")
+	}
+
+	left, err := calendar.NewCalendarEngine().GenerateRomanCalendar("2025", calendar.RomanCalendar)
+	if err != nil {
+		t.Fatalf("GenerateRomanCalendar failed: %v", err)
+	}
+	right, err := calendar.NewCalendarEngine(option).GenerateRomanCalendar("2025", calendar.RomanCalendar)
+	if err != nil {
+		t.Fatalf("GenerateRomanCalendar failed: %v", err)
+	}
+
+	// In 2025 the transferred Epiphany is Sunday 5 January. Epiphanytide then starts a day early, which shifts its
+	// Sunday week numbers, but Lent is unaffected.
+	diffs := calendar.Diff(left, right)
+	dates := make([]string, 0, len(diffs))
+	for _, d := range diffs {
+		dates = append(dates, d.Date)
+	}
+	if !slices.Contains(dates, "2025-01-05") || !slices.Contains(dates, "2025-01-06") {
+		t.Errorf("Expected differences on 5 and 6 January, got %v", dates)
+	}
+	if slices.Contains(dates, "2025-01-07") || slices.Contains(dates, "2025-03-05") {
+		t.Errorf("Unexpected differences, got %v", dates)
+	}
+
+	for _, d := range diffs {
+		if d.Date == "2025-01-05" && d.Right.Celebration != "The Epiphany of the Lord" {
+			t.Errorf("Expected the Epiphany on 5 January, got %q", d.Right.Celebration)
+		}
+	}
+}
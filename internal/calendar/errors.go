@@ -9,6 +9,7 @@ var (
 	ErrParseDateFailed              = errors.New("failed to parse date")
 	ErrUnsupportedCalendarTradition = errors.New("unsupported calendar tradition")
 	ErrValidationFailed             = errors.New("validation failed")
+	ErrUnsupportedYear              = errors.New("unsupported year")
 )
 
 type CalendarError struct {
@@ -87,6 +87,7 @@ func TestErrorConstants(t *testing.T) {
 		{ErrParseDateFailed, "failed to parse date"},
 		{ErrUnsupportedCalendarTradition, "unsupported calendar tradition"},
 		{ErrValidationFailed, "validation failed"},
+		{ErrUnsupportedYear, "unsupported year"},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,149 @@
+package calendar
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/julianstephens/go-utils/generic"
+)
+
+const (
+	// MinSupportedYear is the first year for which the engine computes Easter. The Julian computus
+	// used before the Gregorian reform is only meaningful after the Council of Nicaea (AD 325).
+	MinSupportedYear = 326
+	// MaxSupportedYear is the last year that can be represented in the YYYY-MM-DD date format.
+	MaxSupportedYear = 9999
+)
+
+// GregorianReform is the first day of the Gregorian calendar. Civil dates before it were reckoned in the
+// Julian calendar, so DayKeys for earlier dates also carry their Julian equivalent.
+var GregorianReform = time.Date(1582, time.October, 15, 0, 0, 0, 0, time.UTC)
+
+// JulianDate is a date reckoned in the Julian calendar.
+type JulianDate struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// String formats the Julian date as YYYY-MM-DD.
+func (d JulianDate) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, int(d.Month), d.Day)
+}
+
+// ParseYear parses a calendar year and checks that it lies within the supported range.
+func ParseYear(year string) (int, error) {
+	parsed, err := strconv.Atoi(strings.TrimSpace(year))
+	if err != nil {
+		return 0, &CalendarError{
+			Message: generic.Ptr("invalid year: " + year),
+			Err:     ErrUnsupportedYear,
+			Cause:   err,
+		}
+	}
+	if parsed < MinSupportedYear || parsed > MaxSupportedYear {
+		return 0, &CalendarError{
+			Message: generic.Ptr(
+				fmt.Sprintf("year %d is outside the supported range %d-%d", parsed, MinSupportedYear, MaxSupportedYear),
+			),
+			Err: ErrUnsupportedYear,
+		}
+	}
+	return parsed, nil
+}
+
+// JulianToGregorian converts a Julian calendar date to the same day in the proleptic Gregorian calendar.
+func JulianToGregorian(d JulianDate) (time.Time, error) {
+	if d.Month < time.January || d.Month > time.December || d.Day < 1 || d.Day > julianMonthLength(d.Year, d.Month) {
+		return time.Time{}, &CalendarError{
+			Message: generic.Ptr("invalid Julian date: " + d.String()),
+			Err:     ErrValidationFailed,
+		}
+	}
+	return dateFromJDN(julianToJDN(d.Year, d.Month, d.Day)), nil
+}
+
+// GregorianToJulian converts a proleptic Gregorian date to the same day in the Julian calendar.
+func GregorianToJulian(t time.Time) JulianDate {
+	return julianFromJDN(gregorianToJDN(t.Year(), t.Month(), t.Day()))
+}
+
+// IsPreReform reports whether the given Gregorian date falls before the Gregorian reform.
+func IsPreReform(t time.Time) bool {
+	return civilDate(t.Year(), t.Month(), t.Day()).Before(GregorianReform)
+}
+
+// fixedDate returns the Gregorian date of a day kept on the same calendar date every year, such as Christmas on
+// 25 December. Before the Gregorian reform the day was kept on that date of the Julian calendar, so it is converted
+// and can fall in the following Gregorian year: Christmas 1400 is 1401-01-03.
+func fixedDate(year int, month time.Month, day int) time.Time {
+	date, err := JulianToGregorian(JulianDate{Year: year, Month: month, Day: day})
+	if err == nil && date.Before(GregorianReform) {
+		return date
+	}
+	return civilDate(year, month, day)
+}
+
+// civilDate returns midnight UTC on the given proleptic Gregorian date.
+func civilDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func julianMonthLength(year int, month time.Month) int {
+	switch month {
+	case time.February:
+		if year%4 == 0 {
+			return 29
+		}
+		return 28
+	case time.April, time.June, time.September, time.November:
+		return 30
+	default:
+		return 31
+	}
+}
+
+// gregorianToJDN returns the Julian Day Number of a proleptic Gregorian date.
+func gregorianToJDN(year int, month time.Month, day int) int {
+	a := (14 - int(month)) / 12
+	y := year + 4800 - a
+	m := int(month) + 12*a - 3
+	return day + (153*m+2)/5 + 365*y + y/4 - y/100 + y/400 - 32045
+}
+
+// julianToJDN returns the Julian Day Number of a Julian calendar date.
+func julianToJDN(year int, month time.Month, day int) int {
+	a := (14 - int(month)) / 12
+	y := year + 4800 - a
+	m := int(month) + 12*a - 3
+	return day + (153*m+2)/5 + 365*y + y/4 - 32083
+}
+
+// dateFromJDN returns the proleptic Gregorian date for a Julian Day Number.
+func dateFromJDN(jdn int) time.Time {
+	a := jdn + 32044
+	b := (4*a + 3) / 146097
+	c := a - 146097*b/4
+	d := (4*c + 3) / 1461
+	e := c - 1461*d/4
+	m := (5*e + 2) / 153
+	day := e - (153*m+2)/5 + 1
+	month := m + 3 - 12*(m/10)
+	year := 100*b + d - 4800 + m/10
+	return civilDate(year, time.Month(month), day)
+}
+
+// julianFromJDN returns the Julian calendar date for a Julian Day Number.
+func julianFromJDN(jdn int) JulianDate {
+	c := jdn + 32082
+	d := (4*c + 3) / 1461
+	e := c - 1461*d/4
+	m := (5*e + 2) / 153
+	return JulianDate{
+		Year:  d - 4800 + m/10,
+		Month: time.Month(m + 3 - 12*(m/10)),
+		Day:   e - (153*m+2)/5 + 1,
+	}
+}
@@ -0,0 +1,192 @@
+package calendar_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+)
+
+func TestJulianToGregorian(t *testing.T) {
+	testCases := []struct {
+		julian   calendar.JulianDate
+		expected string
+	}{
+		{calendar.JulianDate{Year: 1582, Month: time.October, Day: 4}, "1582-10-14"},
+		{calendar.JulianDate{Year: 1582, Month: time.October, Day: 5}, "1582-10-15"},
+		{calendar.JulianDate{Year: 1400, Month: time.April, Day: 18}, "1400-04-27"},
+		{calendar.JulianDate{Year: 1500, Month: time.February, Day: 29}, "1500-03-10"},
+		{calendar.JulianDate{Year: 2024, Month: time.December, Day: 25}, "2025-01-07"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.julian.String(), func(t *testing.T) {
+			gregorian, err := calendar.JulianToGregorian(tc.julian)
+			if err != nil {
+				t.Fatalf("JulianToGregorian failed: %v", err)
+			}
+
+			if got := gregorian.Format("2006-01-02"); got != tc.expected {
+				t.Errorf("Expected %s, got %s", tc.expected, got)
+			}
+
+			roundTrip := calendar.GregorianToJulian(gregorian)
+			if roundTrip != tc.julian {
+				t.Errorf("Expected round trip to %s, got %s", tc.julian, roundTrip)
+			}
+		})
+	}
+}
+
+func TestJulianToGregorianInvalidDate(t *testing.T) {
+	_, err := calendar.JulianToGregorian(calendar.JulianDate{Year: 1401, Month: time.February, Day: 29})
+	if err == nil {
+		t.Fatal("Expected error for Feb 29 in a Julian common year")
+	}
+
+	if !errors.Is(err, calendar.ErrValidationFailed) {
+		t.Errorf("Expected validation error, got: %v", err)
+	}
+}
+
+func TestGetEasterJulian(t *testing.T) {
+	ce := calendar.NewCalendarEngine()
+
+	testCases := []struct {
+		year     int
+		expected calendar.JulianDate
+	}{
+		{1400, calendar.JulianDate{Year: 1400, Month: time.April, Day: 18}},
+		{2024, calendar.JulianDate{Year: 2024, Month: time.April, Day: 22}},
+		{2025, calendar.JulianDate{Year: 2025, Month: time.April, Day: 7}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expected.String(), func(t *testing.T) {
+			if got := ce.GetEasterJulian(tc.year); got != tc.expected {
+				t.Errorf("Expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetEasterGregorianPreReform(t *testing.T) {
+	ce := calendar.NewCalendarEngine()
+
+	// Easter 1400 was kept on 18 April (Julian), which is 27 April in the proleptic Gregorian calendar.
+	easter := ce.GetEasterGregorian(1400)
+	if got := easter.Format("2006-01-02"); got != "1400-04-27" {
+		t.Errorf("Expected 1400-04-27, got %s", got)
+	}
+
+	if easter.Weekday() != time.Sunday {
+		t.Errorf("Expected Easter on a Sunday, got %s", easter.Weekday())
+	}
+}
+
+func TestParseYear(t *testing.T) {
+	testCases := []struct {
+		year    string
+		want    int
+		wantErr bool
+	}{
+		{"2026", 2026, false},
+		{"800", 800, false},
+		{"0800", 800, false},
+		{"325", 0, true},
+		{"10000", 0, true},
+		{"", 0, true},
+		{"year", 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.year, func(t *testing.T) {
+			got, err := calendar.ParseYear(tc.year)
+			if tc.wantErr {
+				if !errors.Is(err, calendar.ErrUnsupportedYear) {
+					t.Errorf("Expected unsupported year error, got: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseYear failed: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("Expected %d, got %d", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestGenerateRomanCalendarMedievalYear(t *testing.T) {
+	ce := calendar.NewCalendarEngine()
+
+	days, err := ce.GenerateRomanCalendar("800", calendar.RomanCalendar)
+	if err != nil {
+		t.Fatalf("GenerateRomanCalendar failed: %v", err)
+	}
+
+	if len(days) != 366 {
+		t.Fatalf("Expected 366 days for 800 (leap year), got %d", len(days))
+	}
+
+	if days[0].Date != "0800-01-01" {
+		t.Errorf("Expected first day 0800-01-01, got %s", days[0].Date)
+	}
+
+	if days[0].JulianDate != "0799-12-28" {
+		t.Errorf("Expected Julian date 0799-12-28, got %s", days[0].JulianDate)
+	}
+}
+
+func TestGetRomanDayPostReformHasNoJulianDate(t *testing.T) {
+	ce := calendar.NewCalendarEngine()
+
+	dayKey, err := ce.GetRomanDay("1582-10-15", calendar.RomanCalendar)
+	if err != nil {
+		t.Fatalf("GetRomanDay failed: %v", err)
+	}
+
+	if dayKey.JulianDate != "" {
+		t.Errorf("Expected no Julian date after the reform, got %s", dayKey.JulianDate)
+	}
+}
+
+func TestGetRomanDayPreReformFixedFeasts(t *testing.T) {
+	ce := calendar.NewCalendarEngine()
+
+	// Before the reform, Christmas, the Epiphany and the 27 November that Advent is reckoned from are Julian
+	// dates: in 1400 they are nine days later in the proleptic Gregorian calendar, so Christmas 1400 falls in 1401.
+	testCases := []struct {
+		date        string
+		season      calendar.LiturgicalSeason
+		seasonWeek  int
+		celebration string
+	}{
+		{"1400-01-01", calendar.Advent, 4, ""},
+		{"1400-01-02", calendar.Christmastide, 1, "The Nativity of the Lord"},
+		{"1400-01-14", calendar.Epiphanytide, 1, "The Epiphany of the Lord"},
+		{"1400-12-06", calendar.Ordinary, 25, ""},
+		{"1400-12-07", calendar.Advent, 1, ""},
+		{"1400-12-25", calendar.Advent, 3, ""},
+		{"1401-01-03", calendar.Christmastide, 1, "The Nativity of the Lord"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.date, func(t *testing.T) {
+			dayKey, err := ce.GetRomanDay(tc.date, calendar.RomanCalendar)
+			if err != nil {
+				t.Fatalf("GetRomanDay failed: %v", err)
+			}
+
+			if dayKey.Season != tc.season || dayKey.SeasonWeek != tc.seasonWeek {
+				t.Errorf("Expected %s week %d, got %s week %d",
+					tc.season, tc.seasonWeek, dayKey.Season, dayKey.SeasonWeek)
+			}
+			if dayKey.Celebration != tc.celebration {
+				t.Errorf("Expected celebration %q, got %q", tc.celebration, dayKey.Celebration)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package calendar
+
+import (
+	"strings"
+
+	"github.com/julianstephens/go-utils/generic"
+	"golang.org/x/text/language"
+)
+
+// Locale is the base language of a BCP 47 tag ("de" for "de-AT"), used to choose translated names and cues.
+type Locale string
+
+const (
+	English Locale = "en"
+	Latin   Locale = "la"
+	Spanish Locale = "es"
+	German  Locale = "de"
+	French  Locale = "fr"
+	Italian Locale = "it"
+)
+
+// ParseLocale parses a language tag such as "es" or "es-MX" into its base language.
+func ParseLocale(tag string) (Locale, error) {
+	parsed, err := language.Parse(strings.TrimSpace(tag))
+	if err != nil {
+		return "", &CalendarError{
+			Message: generic.Ptr("invalid language: " + tag),
+			Err:     ErrValidationFailed,
+			Cause:   err,
+		}
+	}
+	base, _ := parsed.Base()
+	return Locale(base.String()), nil
+}
+
+// seasonNames are the bundled translations of the season names. A season without a translation keeps its English
+// name.
+var seasonNames = map[Locale]map[LiturgicalSeason]string{
+	Latin: {
+		Advent:             "Adventus",
+		Christmastide:      "Tempus Nativitatis",
+		Epiphanytide:       "Tempus Epiphaniae",
+		Lent:               "Quadragesima",
+		Triduum:            "Triduum Paschale",
+		Eastertide:         "Tempus Paschale",
+		Ordinary:           "Tempus per Annum",
+		Trinitytide:        "Tempus Trinitatis",
+		TimeAfterPentecost: "Tempus post Pentecosten",
+		NinevehFast:        "Ieiunium Ninivitarum",
+		ApostlesFast:       "Ieiunium Apostolorum",
+		NativityFast:       "Ieiunium Nativitatis",
+	},
+	Spanish: {
+		Advent:             "Adviento",
+		Christmastide:      "Navidad",
+		Epiphanytide:       "Epifanía",
+		Lent:               "Cuaresma",
+		Triduum:            "Triduo Pascual",
+		Eastertide:         "Tiempo Pascual",
+		Ordinary:           "Tiempo Ordinario",
+		Trinitytide:        "Tiempo de la Trinidad",
+		TimeAfterPentecost: "Tiempo después de Pentecostés",
+		NinevehFast:        "Ayuno de Nínive",
+		ApostlesFast:       "Ayuno de los Apóstoles",
+		NativityFast:       "Ayuno de la Natividad",
+	},
+	German: {
+		Advent:             "Advent",
+		Christmastide:      "Weihnachtszeit",
+		Epiphanytide:       "Epiphaniaszeit",
+		Lent:               "Fastenzeit",
+		Triduum:            "Österliches Triduum",
+		Eastertide:         "Osterzeit",
+		Ordinary:           "Zeit im Jahreskreis",
+		Trinitytide:        "Trinitatiszeit",
+		TimeAfterPentecost: "Zeit nach Pfingsten",
+		NinevehFast:        "Fasten von Ninive",
+		ApostlesFast:       "Apostelfasten",
+		NativityFast:       "Geburtsfasten",
+	},
+	French: {
+		Advent:             "Avent",
+		Christmastide:      "Temps de Noël",
+		Epiphanytide:       "Temps de l'Épiphanie",
+		Lent:               "Carême",
+		Triduum:            "Triduum pascal",
+		Eastertide:         "Temps pascal",
+		Ordinary:           "Temps ordinaire",
+		Trinitytide:        "Temps de la Trinité",
+		TimeAfterPentecost: "Temps après la Pentecôte",
+		NinevehFast:        "Jeûne de Ninive",
+		ApostlesFast:       "Jeûne des Apôtres",
+		NativityFast:       "Jeûne de la Nativité",
+	},
+	Italian: {
+		Advent:             "Avvento",
+		Christmastide:      "Tempo di Natale",
+		Epiphanytide:       "Tempo dell'Epifania",
+		Lent:               "Quaresima",
+		Triduum:            "Triduo pasquale",
+		Eastertide:         "Tempo di Pasqua",
+		Ordinary:           "Tempo ordinario",
+		Trinitytide:        "Tempo della Trinità",
+		TimeAfterPentecost: "Tempo dopo Pentecoste",
+		NinevehFast:        "Digiuno di Ninive",
+		ApostlesFast:       "Digiuno degli Apostoli",
+		NativityFast:       "Digiuno della Natività",
+	},
+}
+
+// weekdayNames are the bundled translations of the weekday names.
+var weekdayNames = map[Locale]map[Weekday]string{
+	Latin: {
+		Sunday:    "Dominica",
+		Monday:    "Feria secunda",
+		Tuesday:   "Feria tertia",
+		Wednesday: "Feria quarta",
+		Thursday:  "Feria quinta",
+		Friday:    "Feria sexta",
+		Saturday:  "Sabbatum",
+	},
+	Spanish: {
+		Sunday:    "Domingo",
+		Monday:    "Lunes",
+		Tuesday:   "Martes",
+		Wednesday: "Miércoles",
+		Thursday:  "Jueves",
+		Friday:    "Viernes",
+		Saturday:  "Sábado",
+	},
+	German: {
+		Sunday:    "Sonntag",
+		Monday:    "Montag",
+		Tuesday:   "Dienstag",
+		Wednesday: "Mittwoch",
+		Thursday:  "Donnerstag",
+		Friday:    "Freitag",
+		Saturday:  "Samstag",
+	},
+	French: {
+		Sunday:    "Dimanche",
+		Monday:    "Lundi",
+		Tuesday:   "Mardi",
+		Wednesday: "Mercredi",
+		Thursday:  "Jeudi",
+		Friday:    "Vendredi",
+		Saturday:  "Samedi",
+	},
+	Italian: {
+		Sunday:    "Domenica",
+		Monday:    "Lunedì",
+		Tuesday:   "Martedì",
+		Wednesday: "Mercoledì",
+		Thursday:  "Giovedì",
+		Friday:    "Venerdì",
+		Saturday:  "Sabato",
+	},
+}
+
+// Localized returns the season's name in the locale, or its English name if there is no translation.
+func (s LiturgicalSeason) Localized(locale Locale) string {
+	if name, ok := seasonNames[locale][s]; ok {
+		return name
+	}
+	return s.String()
+}
+
+// Localized returns the weekday's name in the locale, or its English name if there is no translation.
+func (w Weekday) Localized(locale Locale) string {
+	if name, ok := weekdayNames[locale][w]; ok {
+		return name
+	}
+	return w.String()
+}
@@ -0,0 +1,60 @@
+package calendar_test
+
+import (
+	"testing"
+
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+)
+
+func TestParseLocale(t *testing.T) {
+	testCases := []struct {
+		tag      string
+		expected calendar.Locale
+		valid    bool
+	}{
+		{"la", calendar.Latin, true},
+		{"es-MX", calendar.Spanish, true},
+		{"DE", calendar.German, true},
+		{"pt-BR", calendar.Locale("pt"), true},
+		{"not a language", "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.tag, func(t *testing.T) {
+			locale, err := calendar.ParseLocale(tc.tag)
+			if (err == nil) != tc.valid {
+				t.Fatalf("Expected valid=%v for %q, got %v", tc.valid, tc.tag, err)
+			}
+			if locale != tc.expected {
+				t.Errorf("Expected locale %q, got %q", tc.expected, locale)
+			}
+		})
+	}
+}
+
+func TestLocalizedNames(t *testing.T) {
+	testCases := []struct {
+		locale          calendar.Locale
+		expectedSeason  string
+		expectedWeekday string
+	}{
+		{calendar.English, "Lent", "Friday"},
+		{calendar.Latin, "Quadragesima", "Feria sexta"},
+		{calendar.Spanish, "Cuaresma", "Viernes"},
+		{calendar.German, "Fastenzeit", "Freitag"},
+		{calendar.French, "Carême", "Vendredi"},
+		{calendar.Italian, "Quaresima", "Venerdì"},
+		{calendar.Locale("pt"), "Lent", "Friday"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.locale), func(t *testing.T) {
+			if season := calendar.Lent.Localized(tc.locale); season != tc.expectedSeason {
+				t.Errorf("Expected season %q, got %q", tc.expectedSeason, season)
+			}
+			if weekday := calendar.Friday.Localized(tc.locale); weekday != tc.expectedWeekday {
+				t.Errorf("Expected weekday %q, got %q", tc.expectedWeekday, weekday)
+			}
+		})
+	}
+}
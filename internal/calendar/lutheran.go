@@ -0,0 +1,113 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+)
+
+// lutheranCycle returns the season transitions of the Lutheran calendar (Evangelical Lutheran Worship) anchored
+// in the given year. The season after Epiphany runs to Ash Wednesday and the Time after Pentecost to Advent.
+func (ce *CalendarEngine) lutheranCycle(year int) []seasonTransition {
+	easter := ce.easter(year, LutheranCalendar)
+
+	return []seasonTransition{
+		transition(Epiphanytide, ce.epiphany(year, LutheranCalendar)),
+		transition(Lent, easter.AddDate(0, 0, -46)),
+		transition(Triduum, easter.AddDate(0, 0, -3)),
+		transition(Eastertide, easter),
+		transition(TimeAfterPentecost, easter.AddDate(0, 0, 50)),
+		transition(Advent, ce.advent(year, LutheranCalendar)),
+		transition(Christmastide, fixedDate(year, time.December, 25)),
+	}
+}
+
+// lutheranSundayProper names the Sundays of the Lutheran year that have no festival of their own,
+// e.g. "Third Sunday in Lent" or "Twelfth Sunday after Pentecost".
+func (ce *CalendarEngine) lutheranSundayProper(dayKey *DayKey, date time.Time) (string, error) {
+	if dayKey.Season == TimeAfterPentecost {
+		// The Sundays after Pentecost are counted from Pentecost itself, so Holy Trinity is the first.
+		pentecost := ce.easter(date.Year(), LutheranCalendar).AddDate(0, 0, 49)
+		return fmt.Sprintf("%s Sunday after Pentecost", ordinalWord(daysBetween(pentecost, date)/7)), nil
+	}
+
+	inclusive := dayKey.Season != Christmastide && dayKey.Season != Epiphanytide
+	n, err := ce.sundayNumber(dayKey, date, inclusive)
+	if err != nil {
+		return "", err
+	}
+
+	switch dayKey.Season {
+	case Advent:
+		return fmt.Sprintf("%s Sunday of Advent", ordinalWord(n)), nil
+	case Christmastide:
+		return fmt.Sprintf("%s Sunday of Christmas", ordinalWord(n)), nil
+	case Epiphanytide:
+		return fmt.Sprintf("%s Sunday after Epiphany", ordinalWord(n)), nil
+	case Lent:
+		return fmt.Sprintf("%s Sunday in Lent", ordinalWord(n)), nil
+	case Eastertide:
+		return fmt.Sprintf("%s Sunday of Easter", ordinalWord(n)), nil
+	default:
+		return "", nil
+	}
+}
+
+var lutheranFixedFeasts = []fixedFeast{
+	// Principal Festivals
+	{time.January, 6, feast{"Epiphany of Our Lord", White, rankPrincipal}},
+	{time.December, 24, feast{"Nativity of Our Lord: Christmas Eve", White, rankPrincipal}},
+	{time.December, 25, feast{"Nativity of Our Lord: Christmas Day", White, rankPrincipal}},
+	// Lesser Festivals
+	{time.January, 1, feast{"Name of Jesus", White, rankFestival}},
+	{time.January, 18, feast{"Confession of Peter", White, rankFestival}},
+	{time.January, 25, feast{"Conversion of Paul", White, rankFestival}},
+	{time.February, 2, feast{"Presentation of Our Lord", White, rankFestival}},
+	{time.February, 24, feast{"Matthias, Apostle", Red, rankFestival}},
+	{time.March, 19, feast{"Joseph, Guardian of Jesus", White, rankFestival}},
+	{time.March, 25, feast{"Annunciation of Our Lord", White, rankFestival}},
+	{time.April, 25, feast{"Mark, Evangelist", Red, rankFestival}},
+	{time.May, 1, feast{"Philip and James, Apostles", Red, rankFestival}},
+	{time.May, 31, feast{"Visit of Mary to Elizabeth", White, rankFestival}},
+	{time.June, 11, feast{"Barnabas, Apostle", Red, rankFestival}},
+	{time.June, 24, feast{"John the Baptist", White, rankFestival}},
+	{time.June, 29, feast{"Peter and Paul, Apostles", Red, rankFestival}},
+	{time.July, 22, feast{"Mary Magdalene, Apostle", White, rankFestival}},
+	{time.July, 25, feast{"James, Apostle", Red, rankFestival}},
+	{time.August, 15, feast{"Mary, Mother of Our Lord", White, rankFestival}},
+	{time.August, 24, feast{"Bartholomew, Apostle", Red, rankFestival}},
+	{time.September, 14, feast{"Holy Cross Day", Red, rankFestival}},
+	{time.September, 21, feast{"Matthew, Apostle and Evangelist", Red, rankFestival}},
+	{time.September, 29, feast{"Michael and All Angels", White, rankFestival}},
+	{time.October, 18, feast{"Luke, Evangelist", Red, rankFestival}},
+	{time.October, 28, feast{"Simon and Jude, Apostles", Red, rankFestival}},
+	{time.October, 31, feast{"Reformation Day", Red, rankFestival}},
+	{time.November, 1, feast{"All Saints Day", White, rankFestival}},
+	{time.November, 30, feast{"Andrew, Apostle", Red, rankFestival}},
+	{time.December, 21, feast{"Thomas, Apostle", Red, rankFestival}},
+	{time.December, 26, feast{"Stephen, Deacon and Martyr", Red, rankFestival}},
+	{time.December, 27, feast{"John, Apostle and Evangelist", White, rankFestival}},
+	{time.December, 28, feast{"The Holy Innocents, Martyrs", Red, rankFestival}},
+}
+
+var lutheranMovableFeasts = []movableFeast{
+	{-46, feast{"Ash Wednesday", Black, rankPrincipal}},
+	{-7, feast{"Sunday of the Passion", Red, rankPrincipal}},
+	{-3, feast{"Maundy Thursday", White, rankPrincipal}},
+	{-2, feast{"Good Friday", Black, rankPrincipal}},
+	{-1, feast{"Resurrection of Our Lord: Vigil of Easter", White, rankPrincipal}},
+	{0, feast{"Resurrection of Our Lord", White, rankPrincipal}},
+	{39, feast{"Ascension of Our Lord", White, rankPrincipal}},
+	{49, feast{"Day of Pentecost", Red, rankPrincipal}},
+	{56, feast{"The Holy Trinity", White, rankPrincipal}},
+}
+
+// lutheranSundayFeasts returns the Lutheran festivals kept on a Sunday fixed relative to a civil date or to Lent.
+func lutheranSundayFeasts(ce *CalendarEngine, year int) []datedFeast {
+	ashWednesday := ce.easter(year, LutheranCalendar).AddDate(0, 0, -46)
+
+	return []datedFeast{
+		{ce.baptism(year, LutheranCalendar), feast{"Baptism of Our Lord", White, rankPrincipal}},
+		{ashWednesday.AddDate(0, 0, -3), feast{"Transfiguration of Our Lord", White, rankPrincipal}},
+		{ce.advent(year, LutheranCalendar).AddDate(0, 0, -7), feast{"Christ the King", White, rankPrincipal}},
+	}
+}
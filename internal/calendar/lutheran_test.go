@@ -0,0 +1,59 @@
+package calendar_test
+
+import (
+	"testing"
+
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+)
+
+func TestLutheranDays(t *testing.T) {
+	ce := calendar.NewCalendarEngine()
+
+	testCases := []struct {
+		date        string
+		season      calendar.LiturgicalSeason
+		week        int
+		celebration string
+		colour      calendar.Colour
+	}{
+		{"2025-01-19", calendar.Epiphanytide, 2, "Second Sunday after Epiphany", calendar.Green},
+		{"2025-03-02", calendar.Epiphanytide, 8, "Transfiguration of Our Lord", calendar.White},
+		{"2025-03-05", calendar.Lent, 1, "Ash Wednesday", calendar.Black},
+		{"2025-03-30", calendar.Lent, 4, "Fourth Sunday in Lent", calendar.Violet},
+		{"2025-04-13", calendar.Lent, 6, "Sunday of the Passion", calendar.Red},
+		{"2025-04-20", calendar.Eastertide, 1, "Resurrection of Our Lord", calendar.White},
+		{"2025-06-09", calendar.TimeAfterPentecost, 1, "", calendar.Green},
+		{"2025-06-22", calendar.TimeAfterPentecost, 2, "Second Sunday after Pentecost", calendar.Green},
+		{"2025-10-31", calendar.TimeAfterPentecost, 21, "Reformation Day", calendar.Red},
+		{"2025-11-30", calendar.Advent, 1, "First Sunday of Advent", calendar.Blue},
+		{"2025-12-14", calendar.Advent, 3, "Third Sunday of Advent", calendar.Blue},
+		// Before the Gregorian reform the fixed festivals and seasons keep their Julian dates, nine days later in 1400.
+		{"1400-01-01", calendar.Advent, 4, "Nativity of Our Lord: Christmas Eve", calendar.White},
+		{"1400-01-02", calendar.Christmastide, 1, "Nativity of Our Lord: Christmas Day", calendar.White},
+		{"1400-01-14", calendar.Epiphanytide, 1, "Epiphany of Our Lord", calendar.White},
+		{"1400-12-07", calendar.Advent, 1, "First Sunday of Advent", calendar.Blue},
+		{"1400-12-09", calendar.Advent, 1, "Andrew, Apostle", calendar.Red},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.date, func(t *testing.T) {
+			dayKey, err := ce.GetRomanDay(tc.date, calendar.LutheranCalendar)
+			if err != nil {
+				t.Fatalf("GetRomanDay failed: %v", err)
+			}
+
+			if dayKey.Season != tc.season {
+				t.Errorf("Expected season %s, got %s", tc.season, dayKey.Season)
+			}
+			if dayKey.SeasonWeek != tc.week {
+				t.Errorf("Expected season week %d, got %d", tc.week, dayKey.SeasonWeek)
+			}
+			if dayKey.Celebration != tc.celebration {
+				t.Errorf("Expected celebration %q, got %q", tc.celebration, dayKey.Celebration)
+			}
+			if dayKey.Colour != tc.colour {
+				t.Errorf("Expected colour %s, got %s", tc.colour, dayKey.Colour)
+			}
+		})
+	}
+}
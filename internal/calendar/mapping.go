@@ -0,0 +1,66 @@
+package calendar
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/julianstephens/liturgical-time-index/internal"
+)
+
+// DayMapping carries a day of one year to the day with the same liturgical key in another year, e.g. the
+// Wednesday of the second week of Lent. When the target year has no such day, Found is false, Target is nil and
+// Reason says why.
+type DayMapping struct {
+	Source DayKey        `json:"source"`
+	Key    LiturgicalKey `json:"key"`
+	Year   int           `json:"year"`
+	Found  bool          `json:"found"`
+	Target *DayKey       `json:"target,omitempty"`
+	Reason string        `json:"reason,omitempty"`
+}
+
+// KeyOf returns the liturgical key of a day: its season, season week and weekday.
+func KeyOf(day DayKey) LiturgicalKey {
+	return LiturgicalKey{Season: day.Season, Week: day.SeasonWeek, Weekday: day.Weekday}
+}
+
+// MapDay finds the day of the target year that falls on the same liturgical key as the given date. A key can
+// occur twice in a civil year (Christmastide at both ends), in which case the occurrence nearest the source
+// date's place in the year is chosen.
+func (ce *CalendarEngine) MapDay(date string, year int, tradition CalendarTradition) (*DayMapping, error) {
+	source, err := ce.GetRomanDay(date, tradition)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := ParseYear(strconv.Itoa(year)); err != nil {
+		return nil, err
+	}
+
+	mapping := &DayMapping{Source: *source, Key: KeyOf(*source), Year: year}
+	matches, err := ce.Resolve(mapping.Key, fmt.Sprintf("%04d-01-01", year), fmt.Sprintf("%04d-12-31", year), tradition)
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		mapping.Reason = fmt.Sprintf("%d has no %s of week %d of %s",
+			year, mapping.Key.Weekday, mapping.Key.Week, mapping.Key.Season)
+		return mapping, nil
+	}
+
+	sourceDate, _ := time.Parse(internal.DateFormat, source.Date)
+	best, bestDistance := 0, 366
+	for i, match := range matches {
+		matchDate, _ := time.Parse(internal.DateFormat, match.Date)
+		distance := matchDate.YearDay() - sourceDate.YearDay()
+		if distance < 0 {
+			distance = -distance
+		}
+		if distance < bestDistance {
+			best, bestDistance = i, distance
+		}
+	}
+	mapping.Found, mapping.Target = true, &matches[best]
+
+	return mapping, nil
+}
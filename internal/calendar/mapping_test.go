@@ -0,0 +1,55 @@
+package calendar_test
+
+import (
+	"testing"
+
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+)
+
+func TestMapDay(t *testing.T) {
+	ce := calendar.NewCalendarEngine()
+
+	testCases := []struct {
+		date     string
+		year     int
+		expected string
+	}{
+		// Wednesday of the second week of Lent.
+		{"2026-02-25", 2027, "2027-02-17"},
+		// Christmastide occurs at both ends of the year; the occurrence nearest the source date is chosen.
+		{"2025-01-03", 2026, "2026-01-02"},
+		{"2025-12-29", 2026, "2026-12-28"},
+		// Easter 2008 fell on 23 March, too early for an eighth week after Epiphany.
+		{"2025-02-24", 2008, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.date, func(t *testing.T) {
+			mapping, err := ce.MapDay(tc.date, tc.year, calendar.RomanCalendar)
+			if err != nil {
+				t.Fatalf("MapDay failed: %v", err)
+			}
+
+			if tc.expected == "" {
+				if mapping.Found || mapping.Target != nil || mapping.Reason == "" {
+					t.Errorf("Expected no equivalent day in %d, got %+v", tc.year, mapping)
+				}
+				return
+			}
+			if !mapping.Found || mapping.Target.Date != tc.expected {
+				t.Fatalf("Expected %s, got %+v", tc.expected, mapping)
+			}
+			if calendar.KeyOf(*mapping.Target) != mapping.Key {
+				t.Errorf("Expected key %+v, got %+v", mapping.Key, calendar.KeyOf(*mapping.Target))
+			}
+		})
+	}
+}
+
+func TestMapDayRejectsUnsupportedYear(t *testing.T) {
+	ce := calendar.NewCalendarEngine()
+
+	if _, err := ce.MapDay("2026-02-25", 10000, calendar.RomanCalendar); err == nil {
+		t.Error("Expected error for year 10000")
+	}
+}
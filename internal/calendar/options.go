@@ -0,0 +1,63 @@
+package calendar
+
+import (
+	"time"
+
+	"github.com/julianstephens/go-utils/generic"
+)
+
+// EngineOption adjusts how a CalendarEngine computes the calendar.
+type EngineOption func(*CalendarEngine)
+
+// WithEpiphanyOnSunday transfers the Epiphany to the Sunday between 2 and 8 January, as permitted where it is not
+// a holy day of obligation. It applies to the Roman, Anglican and Lutheran traditions.
+func WithEpiphanyOnSunday() EngineOption {
+	return func(ce *CalendarEngine) {
+		ce.epiphanyOnSunday = true
+	}
+}
+
+// engineOptions maps the option names accepted on the command line to their options.
+var engineOptions = map[string]EngineOption{
+	"epiphany-sunday": WithEpiphanyOnSunday(),
+}
+
+// ParseEngineOption returns the engine option with the given name (e.g. "epiphany-sunday").
+func ParseEngineOption(name string) (EngineOption, error) {
+	option, ok := engineOptions[name]
+	if !ok {
+		return nil, &CalendarError{
+			Message: generic.Ptr("unknown engine option: " + name),
+			Err:     ErrValidationFailed,
+		}
+	}
+	return option, nil
+}
+
+// epiphany returns the date the Epiphany is kept in the given year, which is 6 January unless the engine
+// transfers it to a Sunday or an override moves it.
+func (ce *CalendarEngine) epiphany(year int, tradition CalendarTradition) time.Time {
+	if date, ok := ce.anchorOverride(AnchorEpiphany, year); ok {
+		return date
+	}
+	epiphany := fixedDate(year, time.January, 6)
+	if !ce.epiphanyOnSunday {
+		return epiphany
+	}
+	switch tradition {
+	case RomanCalendar, AnglicanCalendar, LutheranCalendar:
+		return sundayOnOrAfter(fixedDate(year, time.January, 2))
+	default:
+		return epiphany
+	}
+}
+
+// baptism returns the date of the Baptism of the Lord: the Sunday after the Epiphany, or the following Monday
+// when a transferred Epiphany falls on 7 or 8 January.
+func (ce *CalendarEngine) baptism(year int, tradition CalendarTradition) time.Time {
+	epiphany := ce.epiphany(year, tradition)
+	if epiphany.After(fixedDate(year, time.January, 6)) {
+		return epiphany.AddDate(0, 0, 1)
+	}
+	return sundayAfter(epiphany)
+}
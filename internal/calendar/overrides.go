@@ -0,0 +1,287 @@
+package calendar
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/julianstephens/go-utils/generic"
+	"github.com/julianstephens/liturgical-time-index/internal"
+)
+
+// AnchorOverride replaces a computed date of one year: the Easter date, the First Sunday of Advent, the
+// Epiphany, or the day a season begins. Source records where the override came from (a plan file, a command-line
+// flag) so that reports can explain why a calendar differs from the computed one.
+type AnchorOverride struct {
+	Anchor Anchor           `json:"anchor,omitempty"`
+	Season LiturgicalSeason `json:"season,omitempty"`
+	Date   time.Time        `json:"date"`
+	Source string           `json:"source"`
+}
+
+// overridableAnchors lists the anchors an override can replace. The other anchors are reckoned from these
+// (Ash Wednesday and Pentecost from Easter), so they move with them.
+var overridableAnchors = []Anchor{AnchorEaster, AnchorAdvent1, AnchorEpiphany}
+
+// Name returns the anchor or season the override replaces.
+func (o AnchorOverride) Name() string {
+	if o.Season != "" {
+		return string(o.Season)
+	}
+	return string(o.Anchor)
+}
+
+// Year returns the year the override applies to.
+func (o AnchorOverride) Year() int {
+	return o.Date.Year()
+}
+
+func (o AnchorOverride) String() string {
+	return fmt.Sprintf("%s=%s", o.Name(), o.Date.Format(internal.DateFormat))
+}
+
+// ParseAnchorOverride parses an override written as name=YYYY-MM-DD, where the name is one of the anchors
+// easter, advent1 or epiphany, or a season whose start is moved (e.g. "lent=2025-03-01").
+func ParseAnchorOverride(spec, source string) (AnchorOverride, error) {
+	name, value, ok := strings.Cut(spec, "=")
+	if !ok {
+		return AnchorOverride{}, &CalendarError{
+			Message: generic.Ptr("expected an override of the form name=YYYY-MM-DD, got " + spec),
+			Err:     ErrValidationFailed,
+		}
+	}
+	return NewAnchorOverride(name, value, source)
+}
+
+// NewAnchorOverride builds an override of the named anchor or season to the given date.
+func NewAnchorOverride(name, date, source string) (AnchorOverride, error) {
+	parsed, err := parseSupportedDate(strings.TrimSpace(date))
+	if err != nil {
+		return AnchorOverride{}, err
+	}
+
+	override := AnchorOverride{Date: parsed, Source: source}
+	if anchor, err := ParseAnchor(name); err == nil {
+		if !generic.Contains(overridableAnchors, anchor) {
+			return AnchorOverride{}, &CalendarError{
+				Message: generic.Ptr(
+					"the " + string(anchor) + " anchor is reckoned from another anchor; override easter, advent1, " +
+						"epiphany or the start of a season instead",
+				),
+				Err: ErrValidationFailed,
+			}
+		}
+		override.Anchor = anchor
+		return override, nil
+	}
+	season, err := ParseSeason(name)
+	if err != nil {
+		return AnchorOverride{}, &CalendarError{
+			Message: generic.Ptr("expected easter, advent1, epiphany or a season name, got " + name),
+			Err:     ErrValidationFailed,
+			Cause:   err,
+		}
+	}
+	override.Season = season
+	return override, nil
+}
+
+// WithAnchorOverrides makes the engine use the given dates instead of the computed ones. When several overrides
+// replace the same anchor or season in the same year, the last one wins.
+func WithAnchorOverrides(overrides ...AnchorOverride) EngineOption {
+	return func(ce *CalendarEngine) {
+		ce.overrides = append(ce.overrides, overrides...)
+	}
+}
+
+// anchorOverride returns the override of an anchor in the given year, if there is one.
+func (ce *CalendarEngine) anchorOverride(anchor Anchor, year int) (time.Time, bool) {
+	for i := len(ce.overrides) - 1; i >= 0; i-- {
+		if ce.overrides[i].Anchor == anchor && ce.overrides[i].Year() == year {
+			return ce.overrides[i].Date, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// hasSeasonOverrides reports whether any override moves the start of a season.
+func (ce *CalendarEngine) hasSeasonOverrides() bool {
+	return generic.Any(ce.overrides, func(o AnchorOverride) bool { return o.Season != "" })
+}
+
+// easter returns the Easter date of the tradition in the given year, honouring any override.
+func (ce *CalendarEngine) easter(year int, tradition CalendarTradition) time.Time {
+	if date, ok := ce.anchorOverride(AnchorEaster, year); ok {
+		return date
+	}
+	if tradition == CopticCalendar || tradition == EthiopianCalendar {
+		return ce.GetEasterAlexandrian(year)
+	}
+	return ce.GetEasterGregorian(year)
+}
+
+// advent returns the First Sunday of Advent of the tradition in the given year, honouring any override.
+func (ce *CalendarEngine) advent(year int, tradition CalendarTradition) time.Time {
+	if date, ok := ce.anchorOverride(AnchorAdvent1, year); ok {
+		return date
+	}
+	if tradition == AmbrosianCalendar {
+		return ambrosianAdventSunday(year)
+	}
+	return adventSunday(year)
+}
+
+// applySeasonOverrides moves the transitions replaced by season overrides. An override replaces the occurrence of
+// its season nearest to the override date, so that, for example, the Ordinary Time before Lent and the Ordinary
+// Time after Pentecost can be moved independently.
+func (ce *CalendarEngine) applySeasonOverrides(transitions []seasonTransition) {
+	for _, override := range ce.overrides {
+		if override.Season == "" {
+			continue
+		}
+		nearest := nearestTransition(transitions, override.Season, override.Date)
+		if nearest < 0 {
+			continue
+		}
+		if transitions[nearest].Origin.Equal(transitions[nearest].From) {
+			transitions[nearest].Origin = override.Date
+		}
+		transitions[nearest].From = override.Date
+	}
+
+	sort.SliceStable(transitions, func(i, j int) bool {
+		return transitions[i].From.Before(transitions[j].From)
+	})
+}
+
+// OverrideReport describes an override for a tradition: the date it replaces and any problems with the result.
+type OverrideReport struct {
+	AnchorOverride
+	Computed *time.Time `json:"computed,omitempty"`
+	Warnings []string   `json:"warnings,omitempty"`
+}
+
+// OverrideReports returns a report for each override of the engine, in the order they were given. Warnings flag
+// overrides that are superseded, that have no effect in the tradition, or that produce an unusual calendar
+// (an Easter that is not a Sunday or falls outside its usual range, a season that starts after the next one).
+func (ce *CalendarEngine) OverrideReports(tradition CalendarTradition) ([]OverrideReport, error) {
+	if !tradition.IsSupported() {
+		return nil, &CalendarError{
+			Err: ErrUnsupportedCalendarTradition,
+		}
+	}
+	// Anchors are compared with the computed dates; seasons with the dates they would start on after the anchor
+	// overrides are applied, since moving Easter moves Lent with it.
+	computed, anchored := *ce, *ce
+	computed.overrides = nil
+	anchored.overrides = generic.Filter(ce.overrides, func(o AnchorOverride) bool { return o.Season == "" })
+
+	reports := make([]OverrideReport, 0, len(ce.overrides))
+	for i, override := range ce.overrides {
+		report := OverrideReport{AnchorOverride: override}
+		for _, later := range ce.overrides[i+1:] {
+			if later.Name() == override.Name() && later.Year() == override.Year() {
+				report.Warnings = append(report.Warnings, fmt.Sprintf("superseded by %s from %s", later, later.Source))
+			}
+		}
+
+		var err error
+		if override.Season != "" {
+			err = anchored.reportSeasonOverride(&report, tradition)
+		} else {
+			err = computed.reportAnchorOverride(&report, tradition)
+		}
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// reportAnchorOverride fills in the computed date of an overridden anchor and checks the override date.
+func (ce *CalendarEngine) reportAnchorOverride(report *OverrideReport, tradition CalendarTradition) error {
+	anchors, err := ce.Anchors(report.Year(), tradition)
+	if err != nil {
+		return err
+	}
+	date, ok := anchors[report.Anchor]
+	if !ok {
+		report.Warnings = append(report.Warnings,
+			fmt.Sprintf("the %s tradition has no %s anchor", tradition, report.Anchor))
+		return nil
+	}
+	if report.Anchor == AnchorEpiphany && (tradition == CopticCalendar || tradition == EthiopianCalendar) {
+		report.Warnings = append(report.Warnings, "the Theophany is reckoned from the Nativity and is not overridden")
+	}
+	report.Computed = &date
+
+	switch report.Anchor {
+	case AnchorEaster:
+		if report.Date.Weekday() != time.Sunday {
+			report.Warnings = append(report.Warnings, "Easter is not a Sunday")
+		}
+		if stats := ce.easterStats(report.Year(), tradition, report.Date); stats.DaysAfterEarliest < 0 ||
+			stats.DaysBeforeLatest < 0 {
+			report.Warnings = append(report.Warnings,
+				fmt.Sprintf("Easter falls outside its range of %s to %s", stats.Earliest, stats.Latest))
+		}
+	case AnchorAdvent1:
+		if report.Date.Weekday() != time.Sunday {
+			report.Warnings = append(report.Warnings, "the First Sunday of Advent is not a Sunday")
+		}
+	}
+	return nil
+}
+
+// reportSeasonOverride fills in the computed start of an overridden season and checks that the season still
+// starts between the seasons around it.
+func (ce *CalendarEngine) reportSeasonOverride(report *OverrideReport, tradition CalendarTradition) error {
+	transitions, err := ce.timeline(report.Year(), tradition)
+	if err != nil {
+		return err
+	}
+
+	nearest := nearestTransition(transitions, report.Season, report.Date)
+	if nearest < 0 {
+		report.Warnings = append(report.Warnings, fmt.Sprintf("the %s tradition has no %s season near %s",
+			tradition, report.Season, report.Date.Format(internal.DateFormat)))
+		return nil
+	}
+	from := transitions[nearest].From
+	report.Computed = &from
+
+	if nearest > 0 && !report.Date.After(transitions[nearest-1].From) {
+		report.Warnings = append(report.Warnings,
+			fmt.Sprintf("%s now starts before %s, which precedes it", report.Season, transitions[nearest-1].Season))
+	}
+	if nearest+1 < len(transitions) && !report.Date.Before(transitions[nearest+1].From) {
+		report.Warnings = append(report.Warnings,
+			fmt.Sprintf("%s now starts on or after %s, which follows it", report.Season, transitions[nearest+1].Season))
+	}
+	return nil
+}
+
+// nearestTransition returns the index of the occurrence of the season nearest to the date, or -1 if the season
+// does not occur within half a year of it. Limiting the distance keeps an override from moving the season of the
+// previous or next cycle in the timeline.
+func nearestTransition(transitions []seasonTransition, season LiturgicalSeason, date time.Time) int {
+	nearest, distance := -1, 0
+	for i, t := range transitions {
+		if t.Season != season {
+			continue
+		}
+		d := daysBetween(t.From, date)
+		if d < 0 {
+			d = -d
+		}
+		if nearest < 0 || d < distance {
+			nearest, distance = i, d
+		}
+	}
+	if distance > 183 {
+		return -1
+	}
+	return nearest
+}
@@ -0,0 +1,176 @@
+package calendar_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+)
+
+func mustOverride(t *testing.T, spec string) calendar.AnchorOverride {
+	t.Helper()
+	override, err := calendar.ParseAnchorOverride(spec, "test")
+	if err != nil {
+		t.Fatalf("ParseAnchorOverride(%q) failed: %v", spec, err)
+	}
+	return override
+}
+
+func TestParseAnchorOverride(t *testing.T) {
+	testCases := []struct {
+		spec  string
+		valid bool
+	}{
+		{"easter=2025-04-27", true},
+		{"advent1=2025-11-23", true},
+		{"epiphany=2025-01-05", true},
+		{"lent=2025-03-01", true},
+		{"pentecost=2025-06-15", false},
+		{"easter", false},
+		{"easter=27-04-2025", false},
+		{"lenten=2025-03-01", false},
+		{"easter=10000-04-27", false},
+	}
+
+	for _, tc := range testCases {
+		_, err := calendar.ParseAnchorOverride(tc.spec, "test")
+		if tc.valid && err != nil {
+			t.Errorf("ParseAnchorOverride(%q) failed: %v", tc.spec, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("Expected error for %q", tc.spec)
+		}
+	}
+}
+
+func TestAnchorOverrides(t *testing.T) {
+	ce := calendar.NewCalendarEngine(calendar.WithAnchorOverrides(
+		mustOverride(t, "easter=2025-04-27"),
+		mustOverride(t, "advent1=2025-11-23"),
+	))
+
+	// Everything reckoned from Easter and Advent moves with the overrides.
+	for _, tradition := range []calendar.CalendarTradition{
+		calendar.RomanCalendar, calendar.AnglicanCalendar, calendar.LutheranCalendar,
+	} {
+		anchors, err := ce.Anchors(2025, tradition)
+		if err != nil {
+			t.Fatalf("Anchors failed: %v", err)
+		}
+		if got := anchors[calendar.AnchorAshWednesday].Format("2006-01-02"); got != "2025-03-12" {
+			t.Errorf("Expected %s Ash Wednesday 2025-03-12, got %s", tradition, got)
+		}
+
+		testCases := []struct {
+			date   string
+			season calendar.LiturgicalSeason
+			week   int
+		}{
+			{"2025-03-12", calendar.Lent, 1},
+			{"2025-04-26", calendar.Triduum, 1},
+			{"2025-04-27", calendar.Eastertide, 1},
+			{"2025-11-23", calendar.Advent, 1},
+		}
+		for _, tc := range testCases {
+			dayKey, err := ce.GetRomanDay(tc.date, tradition)
+			if err != nil {
+				t.Fatalf("GetRomanDay failed: %v", err)
+			}
+			if dayKey.Season != tc.season || dayKey.SeasonWeek != tc.week {
+				t.Errorf("Expected %s %s week %d on %s, got %s week %d",
+					tradition, tc.season, tc.week, tc.date, dayKey.Season, dayKey.SeasonWeek)
+			}
+		}
+	}
+
+	// Other years are not affected.
+	base := calendar.NewCalendarEngine()
+	if !ce.GetEasterGregorian(2026).Equal(base.GetEasterGregorian(2026)) {
+		t.Error("Expected the computus itself to be unaffected by overrides")
+	}
+	anchors, err := ce.Anchors(2026, calendar.RomanCalendar)
+	if err != nil {
+		t.Fatalf("Anchors failed: %v", err)
+	}
+	if !anchors[calendar.AnchorEaster].Equal(base.GetEasterGregorian(2026)) {
+		t.Errorf("Expected the computed Easter in 2026, got %s", anchors[calendar.AnchorEaster])
+	}
+}
+
+func TestSeasonOverrides(t *testing.T) {
+	ce := calendar.NewCalendarEngine(calendar.WithAnchorOverrides(mustOverride(t, "lent=2025-03-01")))
+	base := calendar.NewCalendarEngine()
+
+	for _, tradition := range []calendar.CalendarTradition{calendar.RomanCalendar, calendar.AmbrosianCalendar} {
+		dayKey, err := ce.GetRomanDay("2025-03-01", tradition)
+		if err != nil {
+			t.Fatalf("GetRomanDay failed: %v", err)
+		}
+		if dayKey.Season != calendar.Lent || dayKey.SeasonWeek != 1 {
+			t.Errorf("Expected %s Lent to begin on 2025-03-01, got %+v", tradition, dayKey)
+		}
+	}
+
+	// Apart from the moved boundary, the Roman calendar is unchanged.
+	for _, year := range []int{2024, 2025, 2026} {
+		days, err := ce.GenerateRomanCalendar(strconv.Itoa(year), calendar.RomanCalendar)
+		if err != nil {
+			t.Fatalf("GenerateRomanCalendar failed: %v", err)
+		}
+		expected, err := base.GenerateRomanCalendar(strconv.Itoa(year), calendar.RomanCalendar)
+		if err != nil {
+			t.Fatalf("GenerateRomanCalendar failed: %v", err)
+		}
+		for i := range days {
+			if days[i].Date >= "2025-03-01" && days[i].Date <= "2025-04-16" {
+				continue
+			}
+			if days[i] != expected[i] {
+				t.Errorf("Expected %+v, got %+v", expected[i], days[i])
+			}
+		}
+	}
+}
+
+func TestOverrideReports(t *testing.T) {
+	ce := calendar.NewCalendarEngine(calendar.WithAnchorOverrides(
+		mustOverride(t, "easter=2025-04-24"),
+		mustOverride(t, "triduum=2025-04-30"),
+		mustOverride(t, "easter=2025-04-27"),
+		mustOverride(t, "advent1=2025-11-23"),
+	))
+
+	reports, err := ce.OverrideReports(calendar.RomanCalendar)
+	if err != nil {
+		t.Fatalf("OverrideReports failed: %v", err)
+	}
+	if len(reports) != 4 {
+		t.Fatalf("Expected 4 reports, got %d", len(reports))
+	}
+
+	// Superseded and not a Sunday.
+	if len(reports[0].Warnings) != 2 {
+		t.Errorf("Expected 2 warnings for the first Easter override, got %v", reports[0].Warnings)
+	}
+	if reports[0].Computed == nil || reports[0].Computed.Format("2006-01-02") != "2025-04-20" {
+		t.Errorf("Expected the computed Easter 2025-04-20, got %v", reports[0].Computed)
+	}
+	// The Triduum would begin after Easter.
+	if len(reports[1].Warnings) != 1 {
+		t.Errorf("Expected 1 warning for the Triduum override, got %v", reports[1].Warnings)
+	}
+	if len(reports[2].Warnings) != 1 {
+		t.Errorf("Expected the Easter override outside its range to be flagged, got %v", reports[2].Warnings)
+	}
+	if len(reports[3].Warnings) != 0 {
+		t.Errorf("Expected no warnings for the Advent override, got %v", reports[3].Warnings)
+	}
+
+	reports, err = ce.OverrideReports(calendar.CopticCalendar)
+	if err != nil {
+		t.Fatalf("OverrideReports failed: %v", err)
+	}
+	if len(reports[3].Warnings) != 1 {
+		t.Errorf("Expected the Advent override to have no effect in the Coptic calendar, got %v", reports[3].Warnings)
+	}
+}
@@ -0,0 +1,146 @@
+package calendar
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/julianstephens/go-utils/generic"
+	"github.com/julianstephens/liturgical-time-index/internal"
+)
+
+// LiturgicalKey names a day by its place in the liturgical year, e.g. the Friday of the third week of Lent.
+// An empty Weekday matches every day of the week.
+type LiturgicalKey struct {
+	Season  LiturgicalSeason `json:"season"`
+	Week    int              `json:"week"`
+	Weekday Weekday          `json:"weekday,omitempty"`
+}
+
+// ParseLiturgicalKey parses a season, week and optional weekday such as "lent 3 fri" or "advent 1".
+func ParseLiturgicalKey(fields []string) (LiturgicalKey, error) {
+	if len(fields) < 2 || len(fields) > 3 {
+		return LiturgicalKey{}, &CalendarError{
+			Message: generic.Ptr("expected a season, a week and an optional weekday, e.g. \"lent 3 fri\""),
+			Err:     ErrValidationFailed,
+		}
+	}
+
+	season, err := ParseSeason(fields[0])
+	if err != nil {
+		return LiturgicalKey{}, err
+	}
+	week, err := strconv.Atoi(fields[1])
+	if err != nil || week < 1 || week > 53 {
+		return LiturgicalKey{}, &CalendarError{
+			Message: generic.Ptr("invalid season week: " + fields[1]),
+			Err:     ErrValidationFailed,
+			Cause:   err,
+		}
+	}
+
+	key := LiturgicalKey{Season: season, Week: week}
+	if len(fields) == 3 {
+		if key.Weekday, err = ParseWeekday(fields[2]); err != nil {
+			return LiturgicalKey{}, err
+		}
+	}
+	return key, nil
+}
+
+// ParseSeason parses a season name as used in plans and exports, e.g. "lent" or "christmastide".
+func ParseSeason(name string) (LiturgicalSeason, error) {
+	season := LiturgicalSeason(strings.ToLower(strings.TrimSpace(name)))
+	switch season {
+	case Advent, Christmastide, Epiphanytide, Lent, Triduum, Eastertide, Ordinary,
+		Trinitytide, TimeAfterPentecost, NinevehFast, ApostlesFast, NativityFast:
+		return season, nil
+	default:
+		return "", &CalendarError{
+			Message: generic.Ptr("invalid season: " + name),
+			Err:     ErrValidationFailed,
+		}
+	}
+}
+
+// ParseWeekday parses a weekday given as an abbreviation ("fri") or in full ("Friday").
+func ParseWeekday(name string) (Weekday, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for _, weekday := range []Weekday{Sunday, Monday, Tuesday, Wednesday, Thursday, Friday, Saturday} {
+		if name == string(weekday) || name == strings.ToLower(weekday.String()) {
+			return weekday, nil
+		}
+	}
+	return "", &CalendarError{
+		Message: generic.Ptr("invalid weekday: " + name),
+		Err:     ErrValidationFailed,
+	}
+}
+
+// Matches reports whether the day falls on the liturgical key.
+func (k LiturgicalKey) Matches(day DayKey) bool {
+	return day.Season == k.Season && day.SeasonWeek == k.Week && (k.Weekday == "" || day.Weekday == k.Weekday)
+}
+
+// Resolve returns the days from one date to another, inclusive, that fall on the liturgical key. It is the
+// inverse of GetRomanDay; a key can match several days, for instance Christmastide at both ends of a year.
+func (ce *CalendarEngine) Resolve(key LiturgicalKey, from, to string, tradition CalendarTradition) ([]DayKey, error) {
+	days, err := ce.GenerateCalendarRange(from, to, tradition)
+	if err != nil {
+		return nil, err
+	}
+	return generic.Filter(days, key.Matches), nil
+}
+
+// LastSeasonWeek returns the number of the last week of the run of the season the day belongs to, e.g. 7 for a
+// day in Lent, or the number of weeks of Ordinary Time in that year after Pentecost.
+func (ce *CalendarEngine) LastSeasonWeek(day DayKey) (int, error) {
+	end, err := ce.seasonRunEdge(day, 1)
+	if err != nil {
+		return 0, err
+	}
+	return ce.GetRomanSeasonWeek(end.Format(internal.DateFormat), day.Season, day.Tradition)
+}
+
+// DayOfSeason returns the position of the day in the run of its season, counting the first day as 1.
+func (ce *CalendarEngine) DayOfSeason(day DayKey) (int, error) {
+	start, err := ce.seasonRunEdge(day, -1)
+	if err != nil {
+		return 0, err
+	}
+	date, err := parseSupportedDate(day.Date)
+	if err != nil {
+		return 0, err
+	}
+	return daysBetween(start, date) + 1, nil
+}
+
+// seasonRunEdge returns the last (direction 1) or first (direction -1) day of the run of the day's season,
+// stopping at the supported range of years.
+func (ce *CalendarEngine) seasonRunEdge(day DayKey, direction int) (time.Time, error) {
+	edge, err := parseSupportedDate(day.Date)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	inSeason := func(date time.Time) (bool, error) {
+		if date.Year() < MinSupportedYear || date.Year() > MaxSupportedYear {
+			return false, nil
+		}
+		season, err := ce.GetRomanSeason(date.Format(internal.DateFormat), day.Tradition)
+		return season == day.Season, err
+	}
+
+	// Walk a day at a time: a longer step could jump over a short interruption of the season, such as the
+	// three days of the Fast of Nineveh in the Coptic Epiphanytide.
+	for {
+		ok, err := inSeason(edge.AddDate(0, 0, direction))
+		if err != nil {
+			return time.Time{}, err
+		}
+		if !ok {
+			return edge, nil
+		}
+		edge = edge.AddDate(0, 0, direction)
+	}
+}
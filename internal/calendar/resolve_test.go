@@ -0,0 +1,151 @@
+package calendar_test
+
+import (
+	"testing"
+
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+)
+
+func TestParseLiturgicalKey(t *testing.T) {
+	lent3fri := calendar.LiturgicalKey{Season: calendar.Lent, Week: 3, Weekday: calendar.Friday}
+	advent1sun := calendar.LiturgicalKey{Season: calendar.Advent, Week: 1, Weekday: calendar.Sunday}
+
+	testCases := []struct {
+		fields   []string
+		expected calendar.LiturgicalKey
+		valid    bool
+	}{
+		{[]string{"lent", "3", "fri"}, lent3fri, true},
+		{[]string{"Advent", "1", "Sunday"}, advent1sun, true},
+		{[]string{"ordinary", "12"}, calendar.LiturgicalKey{Season: calendar.Ordinary, Week: 12}, true},
+		{[]string{"lent"}, calendar.LiturgicalKey{}, false},
+		{[]string{"lenten", "3", "fri"}, calendar.LiturgicalKey{}, false},
+		{[]string{"lent", "0", "fri"}, calendar.LiturgicalKey{}, false},
+		{[]string{"lent", "3", "friyay"}, calendar.LiturgicalKey{}, false},
+	}
+
+	for _, tc := range testCases {
+		key, err := calendar.ParseLiturgicalKey(tc.fields)
+		if tc.valid && err != nil {
+			t.Errorf("ParseLiturgicalKey(%v) failed: %v", tc.fields, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("Expected error for %v", tc.fields)
+		}
+		if key != tc.expected {
+			t.Errorf("ParseLiturgicalKey(%v) = %+v, expected %+v", tc.fields, key, tc.expected)
+		}
+	}
+}
+
+func TestResolve(t *testing.T) {
+	ce := calendar.NewCalendarEngine()
+
+	// Easter 2028 is 16 April, so Lent begins on 1 March.
+	key := calendar.LiturgicalKey{Season: calendar.Lent, Week: 3, Weekday: calendar.Friday}
+	days, err := ce.Resolve(key, "2028-01-01", "2028-12-31", calendar.RomanCalendar)
+	if err != nil {
+		t.Fatalf("Resolve failed: %v", err)
+	}
+	if len(days) != 1 || days[0].Date != "2028-03-17" {
+		t.Errorf("Expected 2028-03-17, got %+v", days)
+	}
+
+	// Every day of a week matches when no weekday is given, and Christmastide occurs at both ends of the year.
+	key = calendar.LiturgicalKey{Season: calendar.Christmastide, Week: 1}
+	days, err = ce.Resolve(key, "2024-12-01", "2025-12-31", calendar.RomanCalendar)
+	if err != nil {
+		t.Fatalf("Resolve failed: %v", err)
+	}
+	if len(days) != 14 {
+		t.Errorf("Expected 14 days, got %d", len(days))
+	}
+	for _, day := range days {
+		if back, _ := ce.GetRomanDay(day.Date, calendar.RomanCalendar); !key.Matches(*back) {
+			t.Errorf("Resolved %s does not map back to %+v", day.Date, key)
+		}
+	}
+}
+
+func TestSeasonRunPosition(t *testing.T) {
+	ce := calendar.NewCalendarEngine()
+
+	// For 2025: Lent runs from Ash Wednesday (2025-03-05) to 2025-04-16, Ordinary Time after Pentecost from
+	// 2025-06-09 to 2025-11-29.
+	testCases := []struct {
+		date        string
+		dayOfSeason int
+		lastWeek    int
+	}{
+		{"2025-03-05", 1, 7},
+		{"2025-03-14", 10, 7},
+		{"2025-04-16", 43, 7},
+		{"2025-06-09", 1, 25},
+		{"2025-11-29", 174, 25},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.date, func(t *testing.T) {
+			day, err := ce.GetRomanDay(tc.date, calendar.RomanCalendar)
+			if err != nil {
+				t.Fatalf("GetRomanDay failed: %v", err)
+			}
+			dayOfSeason, err := ce.DayOfSeason(*day)
+			if err != nil {
+				t.Fatalf("DayOfSeason failed: %v", err)
+			}
+			if dayOfSeason != tc.dayOfSeason {
+				t.Errorf("Expected day %d of %s, got %d", tc.dayOfSeason, day.Season, dayOfSeason)
+			}
+			lastWeek, err := ce.LastSeasonWeek(*day)
+			if err != nil {
+				t.Fatalf("LastSeasonWeek failed: %v", err)
+			}
+			if lastWeek != tc.lastWeek {
+				t.Errorf("Expected last week %d of %s, got %d", tc.lastWeek, day.Season, lastWeek)
+			}
+		})
+	}
+}
+
+func TestSeasonRunPositionAfterInterruption(t *testing.T) {
+	ce := calendar.NewCalendarEngine()
+
+	// For Coptic 2025: Epiphanytide runs from 2025-01-19 to 2025-02-09, is interrupted by the Fast of Nineveh
+	// (2025-02-10 to 2025-02-12), and resumes from 2025-02-13 to 2025-02-23.
+	testCases := []struct {
+		date        string
+		dayOfSeason int
+		lastWeek    int
+	}{
+		{"2025-02-09", 22, 4},
+		{"2025-02-10", 1, 1},
+		{"2025-02-13", 1, 6},
+		{"2025-02-16", 4, 6},
+		{"2025-02-17", 5, 6},
+		{"2025-02-23", 11, 6},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.date, func(t *testing.T) {
+			day, err := ce.GetRomanDay(tc.date, calendar.CopticCalendar)
+			if err != nil {
+				t.Fatalf("GetRomanDay failed: %v", err)
+			}
+			dayOfSeason, err := ce.DayOfSeason(*day)
+			if err != nil {
+				t.Fatalf("DayOfSeason failed: %v", err)
+			}
+			if dayOfSeason != tc.dayOfSeason {
+				t.Errorf("Expected day %d of %s, got %d", tc.dayOfSeason, day.Season, dayOfSeason)
+			}
+			lastWeek, err := ce.LastSeasonWeek(*day)
+			if err != nil {
+				t.Fatalf("LastSeasonWeek failed: %v", err)
+			}
+			if lastWeek != tc.lastWeek {
+				t.Errorf("Expected last week %d of %s, got %d", tc.lastWeek, day.Season, lastWeek)
+			}
+		})
+	}
+}
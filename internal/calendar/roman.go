@@ -1,6 +1,7 @@
 package calendar
 
 import (
+	"fmt"
 	"math"
 	"strconv"
 	"time"
@@ -11,37 +12,60 @@ import (
 
 // GenerateRomanCalendar generates a list of DayKey entries for each day in the specified year and tradition.
 // It iterates through each day of the year, checks if it's a valid date, and then generates a DayKey for that date.
+// The year must lie between MinSupportedYear and MaxSupportedYear.
 func (ce *CalendarEngine) GenerateRomanCalendar(year string, tradition CalendarTradition) ([]DayKey, error) {
+	parsedYear, err := ParseYear(year)
+	if err != nil {
+		return nil, err
+	}
+
+	return ce.GenerateCalendarRange(
+		fmt.Sprintf("%04d-01-01", parsedYear),
+		fmt.Sprintf("%04d-12-31", parsedYear),
+		tradition,
+	)
+}
+
+// GenerateCalendarRange generates a DayKey for each day from one date to another, inclusive.
+// Both dates are in ISO8601 format and must fall between MinSupportedYear and MaxSupportedYear.
+func (ce *CalendarEngine) GenerateCalendarRange(from, to string, tradition CalendarTradition) ([]DayKey, error) {
+	start, err := parseSupportedDate(from)
+	if err != nil {
+		return nil, err
+	}
+	end, err := parseSupportedDate(to)
+	if err != nil {
+		return nil, err
+	}
+	if end.Before(start) {
+		return nil, &CalendarError{
+			Message: generic.Ptr("range end " + to + " is before its start " + from),
+			Err:     ErrValidationFailed,
+		}
+	}
+
 	result := []DayKey{}
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		dayKey, err := ce.GetRomanDay(day.Format(internal.DateFormat), tradition)
+		if err != nil {
+			return nil, err
+		}
 
-	for _, month := range []string{"01", "02", "03", "04", "05", "06", "07", "08", "09", "10", "11", "12"} {
-		for day := 1; day <= 31; day++ {
-			date := year + "-" + month + "-" + padZero(day)
-			_, err := time.Parse(internal.DateFormat, date)
-			if err == nil {
-				// valid date, generate DayKey
-				dayKey, err := ce.GetRomanDay(date, tradition)
-				if err != nil {
-					return nil, err
-				}
-
-				if err := ce.validate(dayKey); err != nil {
-					return nil, &CalendarError{
-						Err:   ErrValidationFailed,
-						Cause: err,
-					}
-				}
-
-				result = append(result, *dayKey)
+		if err := ce.validate(dayKey); err != nil {
+			return nil, &CalendarError{
+				Err:   ErrValidationFailed,
+				Cause: err,
 			}
 		}
+
+		result = append(result, *dayKey)
 	}
 	return result, nil
 }
 
 // GetRomanDay generates a DayKey for a given date and tradition by determining the season, season week, and weekday.
 func (ce *CalendarEngine) GetRomanDay(date string, tradition CalendarTradition) (*DayKey, error) {
-	if tradition != RomanCalendar {
+	if !tradition.IsSupported() {
 		return nil, &CalendarError{
 			Err: ErrUnsupportedCalendarTradition,
 		}
@@ -62,23 +86,72 @@ func (ce *CalendarEngine) GetRomanDay(date string, tradition CalendarTradition)
 		return nil, err
 	}
 
-	return &DayKey{
+	dayKey := &DayKey{
 		Date:       date,
 		Tradition:  tradition,
 		Season:     season,
 		SeasonWeek: seasonWeek,
 		Weekday:    weekday,
-	}, nil
+	}
+
+	parsed, err := time.Parse(internal.DateFormat, date)
+	if err != nil {
+		return nil, &CalendarError{
+			Err:   ErrParseDateFailed,
+			Cause: err,
+		}
+	}
+	if IsPreReform(parsed) {
+		dayKey.JulianDate = GregorianToJulian(parsed).String()
+	}
+	if tradition == CopticCalendar || tradition == EthiopianCalendar {
+		nativeDate, err := GregorianToNative(parsed, tradition)
+		if err != nil {
+			return nil, err
+		}
+		dayKey.NativeDate = nativeDate.String()
+	}
+	if err := ce.celebrate(dayKey, parsed); err != nil {
+		return nil, err
+	}
+
+	return dayKey, nil
 }
 
 // GetRomanSeason determines the liturgical season for a given date and tradition by calculating key feast dates and comparing them to the input date.
 func (ce *CalendarEngine) GetRomanSeason(date string, tradition CalendarTradition) (LiturgicalSeason, error) {
-	if tradition == RomanCalendar {
-		return ce.getRomanSeason(date)
+	switch tradition {
+	case RomanCalendar:
+		if !ce.romanFromTimeline(date) {
+			return ce.getRomanSeason(date)
+		}
+		current, err := ce.timelineSeason(date, tradition)
+		if err != nil {
+			return "", err
+		}
+		return current.Season, nil
+	case AmbrosianCalendar, AnglicanCalendar, LutheranCalendar, CopticCalendar, EthiopianCalendar:
+		current, err := ce.timelineSeason(date, tradition)
+		if err != nil {
+			return "", err
+		}
+		return current.Season, nil
+	default:
+		return "", &CalendarError{
+			Err: ErrUnsupportedCalendarTradition,
+		}
 	}
-	return "", &CalendarError{
-		Err: ErrUnsupportedCalendarTradition,
+}
+
+// romanFromTimeline reports whether the Roman season of a date must be resolved from the season transitions rather
+// than by getRomanSeason: when an override moves the start of a season, or before the Gregorian reform, when
+// Christmas is kept on the Julian 25 December and Advent runs into January.
+func (ce *CalendarEngine) romanFromTimeline(date string) bool {
+	if ce.hasSeasonOverrides() {
+		return true
 	}
+	parsed, err := time.Parse(internal.DateFormat, date)
+	return err == nil && IsPreReform(parsed)
 }
 
 // getRomanSeason determines the liturgical season for a given date in the Roman calendar tradition.
@@ -96,7 +169,7 @@ func (ce *CalendarEngine) getRomanSeason(date string) (LiturgicalSeason, error)
 
 	month := parsed.Month()
 	day := parsed.Day()
-	easterDay := ce.GetEasterGregorian(parsed.Year())
+	easterDay := ce.easter(parsed.Year(), RomanCalendar)
 	easterDay = easterDay.Truncate(24 * time.Hour)
 	ashWednesday := easterDay.AddDate(0, 0, -46)
 	ashWednesday = ashWednesday.Truncate(24 * time.Hour)
@@ -104,12 +177,7 @@ func (ce *CalendarEngine) getRomanSeason(date string) (LiturgicalSeason, error)
 	holyThursday = holyThursday.Truncate(24 * time.Hour)
 	pentecost := easterDay.AddDate(0, 0, 49)
 	pentecost = pentecost.Truncate(24 * time.Hour)
-	nov27 := time.Date(parsed.Year(), time.November, 27, 0, 0, 0, 0, time.Local)
-	sundayAfterNov27 := nov27
-	if nov27.Weekday() != time.Sunday {
-		sundayAfterNov27 = nov27.AddDate(0, 0, int(time.Sunday-nov27.Weekday()+7)%7)
-	}
-	sundayAfterNov27 = sundayAfterNov27.Truncate(24 * time.Hour)
+	sundayAfterNov27 := ce.advent(parsed.Year(), RomanCalendar)
 
 	switch month {
 	case time.November:
@@ -121,9 +189,12 @@ func (ce *CalendarEngine) getRomanSeason(date string) (LiturgicalSeason, error)
 		if day >= 25 {
 			return Christmastide, nil
 		}
+		if parsed.Before(sundayAfterNov27) {
+			return Ordinary, nil
+		}
 		return Advent, nil
 	case time.January:
-		if day < 6 {
+		if parsed.Before(ce.epiphany(parsed.Year(), RomanCalendar)) {
 			return Christmastide, nil
 		}
 		return Epiphanytide, nil
@@ -132,7 +203,7 @@ func (ce *CalendarEngine) getRomanSeason(date string) (LiturgicalSeason, error)
 			return Lent, nil
 		}
 		return Epiphanytide, nil
-	case time.March, time.April, time.May:
+	case time.March, time.April, time.May, time.June:
 		if parsed.Before(ashWednesday) {
 			return Epiphanytide, nil
 		}
@@ -152,6 +223,22 @@ func (ce *CalendarEngine) getRomanSeason(date string) (LiturgicalSeason, error)
 	}
 }
 
+// romanCycle returns the season transitions of the Roman calendar anchored in the given year. They match the
+// rules of getRomanSeason and are used instead of it when an override moves the start of a season.
+func (ce *CalendarEngine) romanCycle(year int) []seasonTransition {
+	easter := ce.easter(year, RomanCalendar)
+
+	return []seasonTransition{
+		transition(Epiphanytide, ce.epiphany(year, RomanCalendar)),
+		transition(Lent, easter.AddDate(0, 0, -46)),
+		transition(Triduum, easter.AddDate(0, 0, -3)),
+		transition(Eastertide, easter),
+		transition(Ordinary, easter.AddDate(0, 0, 50)),
+		transition(Advent, ce.advent(year, RomanCalendar)),
+		transition(Christmastide, fixedDate(year, time.December, 25)),
+	}
+}
+
 // GetRomanWeekday determines the weekday for a given date string in ISO8601 format.
 func (ce *CalendarEngine) GetRomanWeekday(date string) (Weekday, error) {
 	parsed, err := time.Parse(internal.DateFormat, date)
@@ -228,7 +315,14 @@ func (ce *CalendarEngine) getRomanSeasonStartDate(
 	season LiturgicalSeason,
 	tradition CalendarTradition,
 ) (time.Time, error) {
-	if tradition != RomanCalendar {
+	switch tradition {
+	case RomanCalendar:
+		if ce.romanFromTimeline(date) {
+			return ce.timelineSeasonStartDate(date, season, tradition)
+		}
+	case AmbrosianCalendar, AnglicanCalendar, LutheranCalendar, CopticCalendar, EthiopianCalendar:
+		return ce.timelineSeasonStartDate(date, season, tradition)
+	default:
 		return time.Time{}, &CalendarError{
 			Err: ErrUnsupportedCalendarTradition,
 		}
@@ -244,29 +338,24 @@ func (ce *CalendarEngine) getRomanSeasonStartDate(
 
 	switch season {
 	case Advent:
-		nov27 := time.Date(parsed.Year(), time.November, 27, 0, 0, 0, 0, time.Local)
-		sundayAfterNov27 := nov27
-		if nov27.Weekday() != time.Sunday {
-			sundayAfterNov27 = nov27.AddDate(0, 0, int(time.Sunday-nov27.Weekday()+7)%7)
-		}
-		return sundayAfterNov27, nil
+		return ce.advent(parsed.Year(), RomanCalendar), nil
 	case Christmastide:
 		if parsed.Month() == time.December {
-			return time.Date(parsed.Year(), time.December, 25, 0, 0, 0, 0, time.Local), nil
+			return fixedDate(parsed.Year(), time.December, 25), nil
 		}
-		return time.Date(parsed.Year()-1, time.December, 25, 0, 0, 0, 0, time.Local), nil
+		return fixedDate(parsed.Year()-1, time.December, 25), nil
 	case Epiphanytide:
-		return time.Date(parsed.Year(), time.January, 6, 0, 0, 0, 0, time.Local), nil
+		return ce.epiphany(parsed.Year(), RomanCalendar), nil
 	case Lent:
-		easterDay := ce.GetEasterGregorian(parsed.Year())
+		easterDay := ce.easter(parsed.Year(), RomanCalendar)
 		return easterDay.AddDate(0, 0, -46), nil
 	case Triduum:
-		easterDay := ce.GetEasterGregorian(parsed.Year())
+		easterDay := ce.easter(parsed.Year(), RomanCalendar)
 		return easterDay.AddDate(0, 0, -3), nil
 	case Eastertide:
-		return ce.GetEasterGregorian(parsed.Year()), nil
+		return ce.easter(parsed.Year(), RomanCalendar), nil
 	case Ordinary:
-		easterDay := ce.GetEasterGregorian(parsed.Year())
+		easterDay := ce.easter(parsed.Year(), RomanCalendar)
 		pentacost := easterDay.AddDate(0, 0, 49)
 		return pentacost.AddDate(0, 0, 1), nil
 	default:
@@ -276,7 +365,33 @@ func (ce *CalendarEngine) getRomanSeasonStartDate(
 
 // Holidays generates a map of key holidays for a given year and tradition, including their dates, seasons, season weeks, and weekdays.
 func (ce *CalendarEngine) Holidays(year int, tradition CalendarTradition) (map[string]DayKey, error) {
-	easterDay := ce.GetEasterGregorian(year)
+	var holidayNames []string
+	var holidayDates []time.Time
+	switch tradition {
+	case AmbrosianCalendar:
+		holidayNames, holidayDates = ce.ambrosianHolidays(year)
+	case CopticCalendar, EthiopianCalendar:
+		holidayNames, holidayDates = ce.alexandrianHolidays(year)
+	default:
+		holidayNames, holidayDates = ce.romanHolidays(year)
+	}
+
+	holidays := make(map[string]DayKey)
+	for i, holiday := range holidayNames {
+		dateStr := holidayDates[i].Format(internal.DateFormat)
+		dayKey, err := ce.GetRomanDay(dateStr, tradition)
+		if err != nil {
+			return nil, err
+		}
+		holidays[holiday] = *dayKey
+	}
+
+	return holidays, nil
+}
+
+// romanHolidays returns the names and dates of the movable feasts of the Roman calendar for a year.
+func (ce *CalendarEngine) romanHolidays(year int) ([]string, []time.Time) {
+	easterDay := ce.easter(year, RomanCalendar)
 	ashWednesday := easterDay.AddDate(0, 0, -46)
 	holyThursday := easterDay.AddDate(0, 0, -3)
 	goodFriday := easterDay.AddDate(0, 0, -2)
@@ -293,22 +408,26 @@ func (ce *CalendarEngine) Holidays(year int, tradition CalendarTradition) (map[s
 		"Pentecost",
 	}
 
-	holidays := make(map[string]DayKey)
-	for i, holiday := range holidayNames {
-		dateStr := holidayDates[i].Format(internal.DateFormat)
-		dayKey, err := ce.GetRomanDay(dateStr, tradition)
-		if err != nil {
-			return nil, err
-		}
-		holidays[holiday] = *dayKey
-	}
+	return holidayNames, holidayDates
+}
 
-	return holidays, nil
+// adventSunday returns the First Sunday of Advent: the fourth Sunday before Christmas, i.e. the Sunday on or
+// after 27 November.
+func adventSunday(year int) time.Time {
+	return sundayOnOrAfter(fixedDate(year, time.November, 27))
 }
 
-func padZero(num int) string {
-	if num < 10 {
-		return "0" + strconv.Itoa(num)
+// parseSupportedDate parses an ISO8601 date and checks that its year is supported.
+func parseSupportedDate(date string) (time.Time, error) {
+	parsed, err := time.Parse(internal.DateFormat, date)
+	if err != nil {
+		return time.Time{}, &CalendarError{
+			Err:   ErrParseDateFailed,
+			Cause: err,
+		}
+	}
+	if _, err := ParseYear(strconv.Itoa(parsed.Year())); err != nil {
+		return time.Time{}, err
 	}
-	return strconv.Itoa(num)
+	return parsed, nil
 }
@@ -477,3 +477,79 @@ func TestEasterSeasonBoundary(t *testing.T) {
 		t.Errorf("Expected day after Pentecost to be Ordinary Time, got %s", afterPentecostDay.Season)
 	}
 }
+
+func TestGenerateRomanCalendarLateBoundaries(t *testing.T) {
+	ce := NewCalendarEngine()
+
+	// 2023: Nov 27 is a Monday, so Advent starts on Dec 3.
+	// 2038: Easter is Apr 25, so Pentecost falls on Jun 13.
+	for _, year := range []string{"2023", "2038"} {
+		t.Run(year, func(t *testing.T) {
+			if _, err := ce.GenerateRomanCalendar(year, RomanCalendar); err != nil {
+				t.Fatalf("GenerateRomanCalendar failed: %v", err)
+			}
+		})
+	}
+
+	dec2, _ := ce.GetRomanDay("2023-12-02", RomanCalendar)
+	if dec2.Season != Ordinary {
+		t.Errorf("Expected 2023-12-02 to be Ordinary Time, got %s", dec2.Season)
+	}
+
+	jun13, _ := ce.GetRomanDay("2038-06-13", RomanCalendar)
+	if jun13.Season != Eastertide {
+		t.Errorf("Expected Pentecost 2038 to be Eastertide, got %s", jun13.Season)
+	}
+}
+
+func TestGetRomanDayOutsideUTC(t *testing.T) {
+	local := time.Local
+	time.Local = time.FixedZone("UTC+2", 2*60*60)
+	t.Cleanup(func() { time.Local = local })
+
+	ce := NewCalendarEngine()
+
+	// Dates are parsed as midnight UTC, so the season boundaries they are compared with must not depend on the
+	// local time zone.
+	testCases := []struct {
+		date   string
+		season LiturgicalSeason
+	}{
+		{"2023-12-02", Ordinary},
+		{"2023-12-03", Advent},
+		{"2025-04-19", Triduum},
+		{"2025-04-20", Eastertide},
+	}
+
+	for _, tc := range testCases {
+		dayKey, err := ce.GetRomanDay(tc.date, RomanCalendar)
+		if err != nil {
+			t.Fatalf("GetRomanDay failed for %s: %v", tc.date, err)
+		}
+		if dayKey.Season != tc.season {
+			t.Errorf("Expected %s to be %s, got %s", tc.date, tc.season, dayKey.Season)
+		}
+	}
+}
+
+func TestGenerateCalendarRange(t *testing.T) {
+	ce := NewCalendarEngine()
+
+	days, err := ce.GenerateCalendarRange("2025-12-30", "2026-01-02", RomanCalendar)
+	if err != nil {
+		t.Fatalf("GenerateCalendarRange failed: %v", err)
+	}
+	if len(days) != 4 {
+		t.Fatalf("Expected 4 days, got %d", len(days))
+	}
+	if days[0].Date != "2025-12-30" || days[3].Date != "2026-01-02" {
+		t.Errorf("Expected range 2025-12-30..2026-01-02, got %s..%s", days[0].Date, days[3].Date)
+	}
+
+	if _, err := ce.GenerateCalendarRange("2026-01-02", "2025-12-30", RomanCalendar); err == nil {
+		t.Error("Expected error for a range that ends before it starts")
+	}
+	if _, err := ce.GenerateCalendarRange("0300-01-01", "0300-01-02", RomanCalendar); err == nil {
+		t.Error("Expected error for an unsupported year")
+	}
+}
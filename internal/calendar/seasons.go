@@ -0,0 +1,141 @@
+package calendar
+
+import (
+	"sort"
+	"time"
+
+	"github.com/julianstephens/go-utils/generic"
+	"github.com/julianstephens/liturgical-time-index/internal"
+)
+
+// seasonTransition marks the day a season begins. The season lasts until the next transition.
+// Origin is the day week numbering counts from, which differs from From when a season resumes
+// after being interrupted (for example Epiphanytide after the Fast of Nineveh).
+type seasonTransition struct {
+	Season LiturgicalSeason
+	From   time.Time
+	Origin time.Time
+}
+
+// timeline returns the season transitions needed to resolve every day of the given civil year, in date order.
+func (ce *CalendarEngine) timeline(year int, tradition CalendarTradition) ([]seasonTransition, error) {
+	var transitions []seasonTransition
+
+	switch tradition {
+	case RomanCalendar:
+		// The Roman seasons are resolved from their cycle only when a season boundary is overridden.
+		for y := year - 1; y <= year; y++ {
+			transitions = append(transitions, ce.romanCycle(y)...)
+		}
+	case AmbrosianCalendar:
+		// Christmastide and Advent of the previous year can still be running on January 1st.
+		for y := year - 1; y <= year; y++ {
+			transitions = append(transitions, ce.ambrosianCycle(y)...)
+		}
+	case AnglicanCalendar:
+		// Christmastide and Advent of the previous year can still be running on January 1st.
+		for y := year - 1; y <= year; y++ {
+			transitions = append(transitions, ce.anglicanCycle(y)...)
+		}
+	case LutheranCalendar:
+		for y := year - 1; y <= year; y++ {
+			transitions = append(transitions, ce.lutheranCycle(y)...)
+		}
+	case CopticCalendar, EthiopianCalendar:
+		// Seasons that start in the previous two years (the Nativity Fast, Christmastide) can still be
+		// running on January 1st, so their cycles are included.
+		for y := year - 2; y <= year; y++ {
+			transitions = append(transitions, ce.alexandrianCycle(y)...)
+		}
+	default:
+		return nil, &CalendarError{
+			Err: ErrUnsupportedCalendarTradition,
+		}
+	}
+
+	sort.SliceStable(transitions, func(i, j int) bool {
+		return transitions[i].From.Before(transitions[j].From)
+	})
+	ce.applySeasonOverrides(transitions)
+	return transitions, nil
+}
+
+// timelineSeason returns the transition in effect on the given date.
+func (ce *CalendarEngine) timelineSeason(date string, tradition CalendarTradition) (*seasonTransition, error) {
+	parsed, err := time.Parse(internal.DateFormat, date)
+	if err != nil {
+		return nil, &CalendarError{
+			Err:   ErrParseDateFailed,
+			Cause: err,
+		}
+	}
+
+	transitions, err := ce.timeline(parsed.Year(), tradition)
+	if err != nil {
+		return nil, err
+	}
+
+	var current *seasonTransition
+	for i := range transitions {
+		if transitions[i].From.After(parsed) {
+			break
+		}
+		current = &transitions[i]
+	}
+	if current == nil {
+		return nil, &CalendarError{
+			Message: generic.Ptr("no season found for date " + date),
+			Err:     ErrValidationFailed,
+		}
+	}
+	return current, nil
+}
+
+// timelineSeasonStartDate returns the week-numbering origin of the most recent occurrence of the season on or
+// before the given date. If the season has not started yet in that year, its first occurrence is returned.
+func (ce *CalendarEngine) timelineSeasonStartDate(
+	date string,
+	season LiturgicalSeason,
+	tradition CalendarTradition,
+) (time.Time, error) {
+	parsed, err := time.Parse(internal.DateFormat, date)
+	if err != nil {
+		return time.Time{}, &CalendarError{
+			Err:   ErrParseDateFailed,
+			Cause: err,
+		}
+	}
+
+	transitions, err := ce.timeline(parsed.Year(), tradition)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var start, next *seasonTransition
+	for i := range transitions {
+		if transitions[i].Season != season {
+			continue
+		}
+		if transitions[i].From.After(parsed) {
+			if next == nil {
+				next = &transitions[i]
+			}
+			continue
+		}
+		start = &transitions[i]
+	}
+
+	switch {
+	case start != nil:
+		return start.Origin, nil
+	case next != nil:
+		return next.Origin, nil
+	default:
+		return time.Time{}, nil
+	}
+}
+
+// transition builds a seasonTransition whose week numbering starts on its first day.
+func transition(season LiturgicalSeason, from time.Time) seasonTransition {
+	return seasonTransition{Season: season, From: from, Origin: from}
+}
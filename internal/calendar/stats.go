@@ -0,0 +1,177 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/julianstephens/liturgical-time-index/internal"
+)
+
+// YearStats summarises the shape of a liturgical year: how long each season runs, where Easter falls within its
+// possible range, and how many Sundays the movable stretches of the year contain.
+type YearStats struct {
+	Year      int               `json:"year"`
+	Tradition CalendarTradition `json:"tradition"`
+	Easter    EasterStats       `json:"easter"`
+	Seasons   []SeasonRun       `json:"seasons"`
+	// SundaysAfterEpiphany counts the Sundays after the Epiphany and before Lent begins. In the Roman calendar
+	// this is also the number of weeks of Ordinary Time before Lent.
+	SundaysAfterEpiphany int `json:"sundays_after_epiphany"`
+	// SundaysAfterPentecost counts the Sundays after Pentecost and before Advent (or the Nativity Fast).
+	SundaysAfterPentecost int `json:"sundays_after_pentecost"`
+	// OrdinaryWeeksBeforePentecost and OrdinaryWeeksAfterPentecost count the numbered weeks of Ordinary Time on
+	// either side of Pentecost. Both are zero in traditions without Ordinary Time. The Roman weeks of Ordinary Time
+	// before Lent, which this calendar keeps as Epiphanytide, are counted from the Baptism of the Lord.
+	OrdinaryWeeksBeforePentecost int `json:"ordinary_weeks_before_pentecost"`
+	OrdinaryWeeksAfterPentecost  int `json:"ordinary_weeks_after_pentecost"`
+	// SundayFeasts lists the fixed-date celebrations that are kept on a Sunday in the year, leaving out those
+	// that give way to the Sunday or to another feast.
+	SundayFeasts []Observance `json:"sunday_feasts"`
+}
+
+// EasterStats places Easter within the range of dates on which it can fall (22 March to 25 April in the
+// calendar its computus uses).
+type EasterStats struct {
+	Date              string `json:"date"`
+	Earliest          string `json:"earliest"`
+	Latest            string `json:"latest"`
+	DaysAfterEarliest int    `json:"days_after_earliest"`
+	DaysBeforeLatest  int    `json:"days_before_latest"`
+}
+
+// SeasonRun is a stretch of consecutive days in the same season. A season can occur more than once in a civil
+// year, for example Christmastide at both ends.
+type SeasonRun struct {
+	Season LiturgicalSeason `json:"season"`
+	Start  string           `json:"start"`
+	End    string           `json:"end"`
+	Days   int              `json:"days"`
+	Weeks  int              `json:"weeks"`
+}
+
+// Observance is a named celebration on a date.
+type Observance struct {
+	Date string `json:"date"`
+	Name string `json:"name"`
+}
+
+// Stats computes the statistics of a civil year of the tradition's calendar.
+func (ce *CalendarEngine) Stats(year int, tradition CalendarTradition) (*YearStats, error) {
+	days, err := ce.GenerateRomanCalendar(fmt.Sprintf("%04d", year), tradition)
+	if err != nil {
+		return nil, err
+	}
+	anchors, err := ce.Anchors(year, tradition)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &YearStats{
+		Year:      year,
+		Tradition: tradition,
+		Easter:    ce.easterStats(year, tradition, anchors[AnchorEaster]),
+		Seasons:   seasonRuns(days),
+	}
+
+	var lentStart, pentecostEnd time.Time
+	for _, run := range stats.Seasons {
+		start, _ := time.Parse(internal.DateFormat, run.Start)
+		switch {
+		case run.Season == Lent && lentStart.IsZero():
+			lentStart = start
+		case (run.Season == Advent || run.Season == NativityFast || run.Season == Christmastide) &&
+			start.After(anchors[AnchorPentecost]) && pentecostEnd.IsZero():
+			pentecostEnd = start
+		}
+	}
+	if pentecostEnd.IsZero() {
+		pentecostEnd = civilDate(year+1, time.January, 1)
+	}
+	stats.SundaysAfterEpiphany = sundaysBetween(anchors[AnchorEpiphany], lentStart)
+	stats.SundaysAfterPentecost = sundaysBetween(anchors[AnchorPentecost], pentecostEnd)
+
+	before, after := map[int]bool{}, map[int]bool{}
+	for _, day := range days {
+		if day.Season != Ordinary {
+			continue
+		}
+		date, _ := time.Parse(internal.DateFormat, day.Date)
+		if date.Before(anchors[AnchorPentecost]) {
+			before[day.SeasonWeek] = true
+		} else {
+			after[day.SeasonWeek] = true
+		}
+	}
+	stats.OrdinaryWeeksBeforePentecost = len(before)
+	stats.OrdinaryWeeksAfterPentecost = len(after)
+	if tradition == RomanCalendar {
+		// Ordinary Time begins the day after the Baptism and runs to the day before Ash Wednesday.
+		stats.OrdinaryWeeksBeforePentecost = (daysBetween(ce.baptism(year, tradition), lentStart) + 5) / 7
+	}
+
+	fixed := map[Observance]bool{}
+	// Before the Gregorian reform the feasts of the previous year's last days of December fall in January.
+	for y := year - 1; y <= year; y++ {
+		feasts, err := ce.fixedFeasts(y, tradition)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range feasts {
+			fixed[Observance{Date: f.Date.Format(internal.DateFormat), Name: f.Name}] = true
+		}
+	}
+	stats.SundayFeasts = []Observance{}
+	for _, day := range days {
+		observance := Observance{Date: day.Date, Name: day.Celebration}
+		if day.Weekday == Sunday && fixed[observance] {
+			stats.SundayFeasts = append(stats.SundayFeasts, observance)
+		}
+	}
+
+	return stats, nil
+}
+
+// easterStats places the year's Easter within its range. Easter falls between 22 March and 25 April in the
+// calendar of its computus, so the range is converted from the Julian calendar for the Alexandrian Easter and for
+// years before the Gregorian reform.
+func (ce *CalendarEngine) easterStats(year int, tradition CalendarTradition, easter time.Time) EasterStats {
+	earliest, latest := civilDate(year, time.March, 22), civilDate(year, time.April, 25)
+	if year < 1583 || tradition == CopticCalendar || tradition == EthiopianCalendar {
+		earliest, _ = JulianToGregorian(JulianDate{Year: year, Month: time.March, Day: 22})
+		latest, _ = JulianToGregorian(JulianDate{Year: year, Month: time.April, Day: 25})
+	}
+
+	return EasterStats{
+		Date:              easter.Format(internal.DateFormat),
+		Earliest:          earliest.Format(internal.DateFormat),
+		Latest:            latest.Format(internal.DateFormat),
+		DaysAfterEarliest: daysBetween(earliest, easter),
+		DaysBeforeLatest:  daysBetween(easter, latest),
+	}
+}
+
+// seasonRuns groups consecutive days of the same season.
+func seasonRuns(days []DayKey) []SeasonRun {
+	runs := []SeasonRun{}
+	for _, day := range days {
+		if n := len(runs); n > 0 && runs[n-1].Season == day.Season {
+			runs[n-1].End = day.Date
+			runs[n-1].Days++
+			continue
+		}
+		runs = append(runs, SeasonRun{Season: day.Season, Start: day.Date, End: day.Date, Days: 1})
+	}
+	for i := range runs {
+		runs[i].Weeks = (runs[i].Days + 6) / 7
+	}
+	return runs
+}
+
+// sundaysBetween counts the Sundays strictly after one date and before another.
+func sundaysBetween(after, before time.Time) int {
+	count := 0
+	for sunday := sundayAfter(after); sunday.Before(before); sunday = sunday.AddDate(0, 0, 7) {
+		count++
+	}
+	return count
+}
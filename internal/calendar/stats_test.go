@@ -0,0 +1,106 @@
+package calendar_test
+
+import (
+	"testing"
+
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+)
+
+func TestStats(t *testing.T) {
+	ce := calendar.NewCalendarEngine()
+
+	testCases := []struct {
+		year                  int
+		tradition             calendar.CalendarTradition
+		easter                string
+		daysAfterEarliest     int
+		sundaysAfterEpiphany  int
+		sundaysAfterPentecost int
+		ordinaryBefore        int
+		ordinaryAfter         int
+	}{
+		{2025, calendar.RomanCalendar, "2025-04-20", 29, 8, 24, 8, 25},
+		{2025, calendar.AnglicanCalendar, "2025-04-20", 29, 8, 24, 5, 1},
+		{2025, calendar.CopticCalendar, "2025-04-20", 16, 5, 24, 0, 20},
+		// Before the Gregorian reform, the range of Easter dates is the Julian 22 March to 25 April, and the
+		// Epiphany (1500-01-16) and Advent (1500-12-09) are kept on their Julian dates.
+		{1500, calendar.RomanCalendar, "1500-04-29", 28, 8, 24, 8, 25},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.tradition), func(t *testing.T) {
+			stats, err := ce.Stats(tc.year, tc.tradition)
+			if err != nil {
+				t.Fatalf("Stats failed: %v", err)
+			}
+
+			if stats.Easter.Date != tc.easter {
+				t.Errorf("Expected Easter %s, got %s", tc.easter, stats.Easter.Date)
+			}
+			if stats.Easter.DaysAfterEarliest != tc.daysAfterEarliest {
+				t.Errorf("Expected Easter %d days after the earliest date, got %d",
+					tc.daysAfterEarliest, stats.Easter.DaysAfterEarliest)
+			}
+			if stats.Easter.DaysAfterEarliest+stats.Easter.DaysBeforeLatest != 34 {
+				t.Errorf("Expected a 35-day Easter range, got %+v", stats.Easter)
+			}
+			if stats.SundaysAfterEpiphany != tc.sundaysAfterEpiphany {
+				t.Errorf("Expected %d Sundays after Epiphany, got %d",
+					tc.sundaysAfterEpiphany, stats.SundaysAfterEpiphany)
+			}
+			if stats.SundaysAfterPentecost != tc.sundaysAfterPentecost {
+				t.Errorf("Expected %d Sundays after Pentecost, got %d",
+					tc.sundaysAfterPentecost, stats.SundaysAfterPentecost)
+			}
+			if stats.OrdinaryWeeksBeforePentecost != tc.ordinaryBefore ||
+				stats.OrdinaryWeeksAfterPentecost != tc.ordinaryAfter {
+				t.Errorf("Expected %d/%d Ordinary weeks around Pentecost, got %d/%d",
+					tc.ordinaryBefore, tc.ordinaryAfter,
+					stats.OrdinaryWeeksBeforePentecost, stats.OrdinaryWeeksAfterPentecost)
+			}
+
+			days := 0
+			for _, run := range stats.Seasons {
+				days += run.Days
+			}
+			if days != 365 {
+				t.Errorf("Expected the season runs to cover 365 days, got %d", days)
+			}
+		})
+	}
+}
+
+func TestStatsSundayFeasts(t *testing.T) {
+	ce := calendar.NewCalendarEngine()
+
+	stats, err := ce.Stats(2025, calendar.RomanCalendar)
+	if err != nil {
+		t.Fatalf("Stats failed: %v", err)
+	}
+
+	found := false
+	for _, observance := range stats.SundayFeasts {
+		if observance.Date == "2025-06-29" && observance.Name == "Saints Peter and Paul" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected Saints Peter and Paul on a Sunday in 2025, got %+v", stats.SundayFeasts)
+	}
+}
+
+func TestStatsSundayFeastsGiveWay(t *testing.T) {
+	ce := calendar.NewCalendarEngine()
+
+	// Saint John (27 December) falls on a Sunday in 2026, which keeps the Holy Family instead.
+	stats, err := ce.Stats(2026, calendar.RomanCalendar)
+	if err != nil {
+		t.Fatalf("Stats failed: %v", err)
+	}
+
+	for _, observance := range stats.SundayFeasts {
+		if observance.Date == "2026-12-27" {
+			t.Errorf("Expected no fixed feast kept on 2026-12-27, got %s", observance.Name)
+		}
+	}
+}
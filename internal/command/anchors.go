@@ -0,0 +1,67 @@
+package command
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/julianstephens/go-utils/cliutil"
+	"github.com/julianstephens/liturgical-time-index/internal"
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+	"github.com/julianstephens/liturgical-time-index/internal/plan"
+)
+
+// anchorEngine builds a calendar engine that applies the anchor overrides of the given plans, in order, followed
+// by those of the --anchor flags, which take precedence. Each override is reported with where it came from and any
+// warnings. When quiet is set, because the command writes data to standard output, only the warnings are
+// reported, on standard error.
+func anchorEngine(
+	tradition calendar.CalendarTradition,
+	plans []*plan.Plan,
+	specs []string,
+	quiet bool,
+	opts ...calendar.EngineOption,
+) (*calendar.CalendarEngine, error) {
+	var overrides []calendar.AnchorOverride
+	for _, p := range plans {
+		planOverrides, err := p.AnchorOverrides("plan file")
+		if err != nil {
+			cliutil.PrintError("Invalid anchor override in plan file")
+			return nil, err
+		}
+		overrides = append(overrides, planOverrides...)
+	}
+	for _, spec := range specs {
+		override, err := calendar.ParseAnchorOverride(spec, "--anchor")
+		if err != nil {
+			cliutil.PrintError(fmt.Sprintf("Invalid anchor override: %s", spec))
+			return nil, err
+		}
+		overrides = append(overrides, override)
+	}
+
+	ce := calendar.NewCalendarEngine(append(opts, calendar.WithAnchorOverrides(overrides...))...)
+	reports, err := ce.OverrideReports(tradition)
+	if err != nil {
+		cliutil.PrintError("Unable to check anchor overrides")
+		return nil, err
+	}
+
+	for _, report := range reports {
+		if !quiet {
+			computed := ""
+			if report.Computed != nil {
+				computed = fmt.Sprintf(" (computed %s)", report.Computed.Format(internal.DateFormat))
+			}
+			cliutil.PrintInfo(fmt.Sprintf("Overriding %s%s from %s", report.AnchorOverride, computed, report.Source))
+		}
+		for _, warning := range report.Warnings {
+			if quiet {
+				fmt.Fprintf(os.Stderr, "! %s: %s\n", report.AnchorOverride, warning)
+				continue
+			}
+			cliutil.PrintWarning(fmt.Sprintf("%s: %s", report.AnchorOverride, warning))
+		}
+	}
+
+	return ce, nil
+}
@@ -2,56 +2,82 @@ package command
 
 import (
 	"fmt"
-
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
+	"slices"
+	"strings"
 
 	"github.com/julianstephens/go-utils/cliutil"
 	"github.com/julianstephens/go-utils/helpers"
 	"github.com/julianstephens/liturgical-time-index/internal/calendar"
-	"github.com/julianstephens/liturgical-time-index/internal/compile"
 	"github.com/julianstephens/liturgical-time-index/internal/output"
 	"github.com/julianstephens/liturgical-time-index/internal/plan"
+	"github.com/julianstephens/liturgical-time-index/internal/query"
 )
 
 type BuildCmd struct {
-	Year         string  `name:"year"      help:"The year to build the index for."`
-	Plan         string  `name:"plan"      help:"The path to the plan file to build the index from."             default:"./plan.yaml"`
-	Tradition    string  `name:"tradition" help:"The liturgical tradition to build the index for."               default:"roman"       enum:"roman"`
-	ICSPath      *string `name:"out"       help:"The path to output the ICalendar file to (e.g. ./calendar.ics)"                                                                                                    required:"" xor:"md,out"`
-	MarkdownPath *string `name:"md"        help:"The path to output the Markdown file to (e.g. ./calendar.md)"                                                                                                      required:"" xor:"md,out"`
-	MarkdownType string  `name:"type"      help:"Whether to output the full calendar or a specific season."      default:"annual"      enum:"annual,advent,christmastide,epiphanytide,lent,triduum,easter,ordinary"`
-	Verbose      bool    `name:"verbose"   help:"Enable verbose logging."`
+	Year         string   `name:"year"      help:"The year to build the index for (326-9999)."`
+	Plan         []string `name:"plan"      help:"The path to the plan file to build the index from. Repeatable." default:"./plan.yaml"`
+	Merge        string   `name:"merge"     help:"How to combine several plans: override or stack."               default:"override"    enum:"override,stack"`
+	Tradition    string   `name:"tradition" help:"The liturgical tradition to build the index for."               default:"roman"       enum:"roman,ambrosian,anglican,lutheran,coptic,ethiopian"`
+	ICSPath      *string  `name:"out"       help:"The path to output the ICalendar file to (e.g. ./calendar.ics)"                                                                                                    required:"" xor:"md,out"`
+	MarkdownPath *string  `name:"md"        help:"The path to output the Markdown file to (e.g. ./calendar.md)"                                                                                                      required:"" xor:"md,out"`
+	Query        *string  `name:"query"     help:"Only include days matching a query (e.g. \"season=lent and weekday=fri\")."`
+	Anchors      []string `name:"anchor"    help:"Override a computed date (e.g. easter=2025-04-27 or lent=2025-03-01). Repeatable."`
+	Lang         *string  `name:"lang"      help:"The language to choose cues and name seasons and weekdays in (e.g. la, es, de, fr, it)."`
+	Strict       bool     `name:"strict"    help:"Fail if the plan leaves any day to its defaults."`
+	Verbose      bool     `name:"verbose"   help:"Enable verbose logging."`
 }
 
 func (c *BuildCmd) Run() error {
-	ce := calendar.NewCalendarEngine()
+	if _, err := calendar.ParseYear(c.Year); err != nil {
+		cliutil.PrintError(fmt.Sprintf("Unsupported year: %s", c.Year))
+		return err
+	}
 
-	p, err := plan.LoadAndValidatePlan(c.Plan)
+	plans, err := loadPlanSet(c.Plan, c.Merge)
 	if err != nil {
-		cliutil.PrintError("Unable to load and validate plan file")
+		return err
+	}
+	if err := plans.setLang(c.Lang); err != nil {
 		return err
 	}
 
+	var q *query.Query
+	if c.Query != nil {
+		q, err = query.Parse(*c.Query)
+		if err != nil {
+			cliutil.PrintError("Invalid query")
+			return err
+		}
+	}
+
 	tradition := calendar.CalendarTradition(c.Tradition)
-	if tradition == "" {
+	if !tradition.IsSupported() {
 		cliutil.PrintError(fmt.Sprintf("Unsupported tradition: %s", c.Tradition))
 		return fmt.Errorf("unsupported tradition: %s", c.Tradition)
 	}
 
+	ce, err := anchorEngine(tradition, plans.plans, c.Anchors, false)
+	if err != nil {
+		return err
+	}
+
 	calendar, err := ce.GenerateRomanCalendar(c.Year, tradition)
 	if err != nil {
 		cliutil.PrintError("Unable to generate calendar")
 		return err
 	}
 
-	entries := make([]plan.FormattedEntry, len(calendar))
-	caser := cases.Title(language.English)
-	for i, day := range calendar {
-		if c.MarkdownType != "annual" && string(day.Season) != caser.String(c.MarkdownType) {
-			continue
+	if q != nil {
+		calendar, err = q.Filter(ce, calendar)
+		if err != nil {
+			cliutil.PrintError("Unable to evaluate query")
+			return err
 		}
-		entry, err := compile.Compile(day, *p)
+	}
+
+	entries := make([]plan.FormattedEntry, 0, len(calendar))
+	for _, day := range calendar {
+		dayEntries, err := plans.compile(ce, day)
 		if err != nil {
 			cliutil.PrintError("Unable to compile calendar and plan into entries")
 			return err
@@ -68,7 +94,13 @@ func (c *BuildCmd) Run() error {
 				),
 			)
 		}
-		entries[i] = *entry
+		entries = append(entries, dayEntries...)
+	}
+
+	if c.Strict {
+		if err := checkStrict(entries); err != nil {
+			return err
+		}
 	}
 
 	if c.ICSPath != nil {
@@ -97,3 +129,27 @@ func (c *BuildCmd) Run() error {
 
 	return nil
 }
+
+// strictDatesShown is how many of the dates that fell to the defaults --strict names.
+const strictDatesShown = 10
+
+// checkStrict fails if any entry fell to the defaults of its plan, listing the dates.
+func checkStrict(entries []plan.FormattedEntry) error {
+	dates := []string{}
+	for _, entry := range entries {
+		if entry.Resolution == plan.ResolvedDefault && !slices.Contains(dates, entry.Key.Date) {
+			dates = append(dates, entry.Key.Date)
+		}
+	}
+	if len(dates) == 0 {
+		return nil
+	}
+	shown := dates
+	if len(shown) > strictDatesShown {
+		more := fmt.Sprintf("and %d more", len(dates)-strictDatesShown)
+		shown = append(shown[:strictDatesShown:strictDatesShown], more)
+	}
+	cliutil.PrintError(fmt.Sprintf("%d days fall to the plan's defaults: %s", len(dates), strings.Join(shown, ", ")))
+	cliutil.PrintInfo("Run lti coverage to see how the plan resolves each day")
+	return fmt.Errorf("%d days fall to the plan's defaults", len(dates))
+}
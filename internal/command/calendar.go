@@ -0,0 +1,166 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/julianstephens/go-utils/cliutil"
+	"github.com/julianstephens/go-utils/helpers"
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+	"github.com/julianstephens/liturgical-time-index/internal/output"
+)
+
+type CalendarCmd struct {
+	Export CalendarExportCmd `help:"Export the computed calendar without a plan."                   cmd:"" name:"export"`
+	Diff   CalendarDiffCmd   `help:"Compare two calendars and report the days on which they differ." cmd:"" name:"diff"`
+}
+
+type CalendarExportCmd struct {
+	Year      *string  `name:"year"      help:"The year to export (326-9999)."                                            xor:"year-from,year-to"`
+	From      *string  `name:"from"      help:"The first date to export (e.g. 2025-11-30). Requires --to."                xor:"year-from"`
+	To        *string  `name:"to"        help:"The last date to export (e.g. 2026-01-06). Requires --from."               xor:"year-to"`
+	Tradition string   `name:"tradition" help:"The liturgical tradition to export the calendar for."                      default:"roman" enum:"roman,ambrosian,anglican,lutheran,coptic,ethiopian"`
+	Format    string   `name:"format"    help:"The export format."                                                        default:"json"  enum:"json,csv,yaml"`
+	Out       *string  `name:"out"       help:"The path to write the export to. If not provided, writes to standard output."`
+	Anchors   []string `name:"anchor"    help:"Override a computed date (e.g. easter=2025-04-27 or lent=2025-03-01). Repeatable."`
+}
+
+func (c *CalendarExportCmd) Run() (retErr error) {
+	tradition := calendar.CalendarTradition(c.Tradition)
+	ce, err := anchorEngine(tradition, nil, c.Anchors, c.Out == nil)
+	if err != nil {
+		return err
+	}
+
+	from, to, err := dateRange(c.Year, c.From, c.To)
+	if err != nil {
+		return err
+	}
+
+	days, err := ce.GenerateCalendarRange(from, to, tradition)
+	if err != nil {
+		cliutil.PrintError("Unable to generate calendar")
+		return err
+	}
+
+	w := os.Stdout
+	if c.Out != nil {
+		if helpers.Exists(*c.Out) {
+			cliutil.PrintError(fmt.Sprintf("Output file already exists: %s", *c.Out))
+			return fmt.Errorf("output file already exists: %s", *c.Out)
+		}
+
+		f, err := os.OpenFile(filepath.Clean(*c.Out), os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0600)
+		if err != nil {
+			cliutil.PrintError(fmt.Sprintf("Unable to create output file: %s", *c.Out))
+			return err
+		}
+		defer func() {
+			if closeErr := f.Close(); closeErr != nil && retErr == nil {
+				retErr = closeErr
+			}
+		}()
+		w = f
+	}
+
+	if err := output.Calendar(days, output.CalendarFormat(c.Format), w); err != nil {
+		cliutil.PrintError("Unable to export calendar")
+		return err
+	}
+
+	return nil
+}
+
+type CalendarDiffCmd struct {
+	Year         string   `name:"year"         help:"The year to compare (326-9999)."                                      required:""`
+	Left         string   `name:"left"         help:"The tradition of the left calendar."                                  default:"roman"  enum:"roman,ambrosian,anglican,lutheran,coptic,ethiopian"`
+	Right        string   `name:"right"        help:"The tradition of the right calendar."                                 default:"roman"  enum:"roman,ambrosian,anglican,lutheran,coptic,ethiopian"`
+	LeftOptions  []string `name:"left-option"  help:"An engine option for the left calendar (e.g. epiphany-sunday). Repeatable."`
+	RightOptions []string `name:"right-option" help:"An engine option for the right calendar (e.g. epiphany-sunday). Repeatable."`
+	Format       string   `name:"format"       help:"The output format."                                                   default:"table"  enum:"table,json"`
+}
+
+func (c *CalendarDiffCmd) Run() error {
+	left, err := generateWithOptions(c.Year, calendar.CalendarTradition(c.Left), c.LeftOptions)
+	if err != nil {
+		cliutil.PrintError("Unable to generate left calendar")
+		return err
+	}
+	right, err := generateWithOptions(c.Year, calendar.CalendarTradition(c.Right), c.RightOptions)
+	if err != nil {
+		cliutil.PrintError("Unable to generate right calendar")
+		return err
+	}
+
+	diffs := calendar.Diff(left, right)
+
+	if c.Format == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(diffs); err != nil {
+			cliutil.PrintError("Unable to encode differences as JSON")
+			return err
+		}
+		return nil
+	}
+
+	if len(diffs) == 0 {
+		cliutil.PrintSuccess("The calendars agree on every day.")
+		return nil
+	}
+
+	rows := [][]string{{"Date", "Left", "Right", "Differs"}}
+	for _, d := range diffs {
+		rows = append(rows, []string{d.Date, describeDay(d.Left), describeDay(d.Right), strings.Join(d.Fields, ", ")})
+	}
+	cliutil.PrintTable(rows)
+	fmt.Println()
+	cliutil.PrintInfo(fmt.Sprintf("%d of %d days differ", len(diffs), len(left)))
+
+	return nil
+}
+
+// generateWithOptions generates a year of the calendar with an engine configured by option names.
+func generateWithOptions(year string, tradition calendar.CalendarTradition, names []string) ([]calendar.DayKey, error) {
+	opts := make([]calendar.EngineOption, 0, len(names))
+	for _, name := range names {
+		opt, err := calendar.ParseEngineOption(name)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, opt)
+	}
+	return calendar.NewCalendarEngine(opts...).GenerateRomanCalendar(year, tradition)
+}
+
+// describeDay summarises a day as its season and week, followed by its celebration if it has one.
+func describeDay(day calendar.DayKey) string {
+	description := day.Season.String() + " " + strconv.Itoa(day.SeasonWeek)
+	if day.Celebration != "" {
+		description += ": " + day.Celebration
+	}
+	return description
+}
+
+// dateRange turns the --year or --from/--to flags of a command into the first and last dates to generate,
+// printing what is wrong with the flags if they give no range.
+func dateRange(year, from, to *string) (string, string, error) {
+	switch {
+	case year != nil:
+		parsedYear, err := calendar.ParseYear(*year)
+		if err != nil {
+			cliutil.PrintError(fmt.Sprintf("Unsupported year: %s", *year))
+			return "", "", err
+		}
+		return fmt.Sprintf("%04d-01-01", parsedYear), fmt.Sprintf("%04d-12-31", parsedYear), nil
+	case from != nil && to != nil:
+		return *from, *to, nil
+	default:
+		cliutil.PrintError("Either --year or both --from and --to are required")
+		return "", "", fmt.Errorf("either --year or both --from and --to are required")
+	}
+}
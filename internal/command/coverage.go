@@ -0,0 +1,117 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/julianstephens/go-utils/cliutil"
+	"github.com/julianstephens/go-utils/generic"
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+	"github.com/julianstephens/liturgical-time-index/internal/compile"
+	"github.com/julianstephens/liturgical-time-index/internal/plan"
+)
+
+type CoverageCmd struct {
+	Year      string   `name:"year"      help:"The year to report on (326-9999)."                                             required:""`
+	Plan      []string `name:"plan"      help:"The path to the plan file to report on. Repeatable; later plans win per rule." default:"./plan.yaml"`
+	Tradition string   `name:"tradition" help:"The liturgical tradition to report on."                                        default:"roman" enum:"roman,ambrosian,anglican,lutheran,coptic,ethiopian"`
+	Anchors   []string `name:"anchor"    help:"Override a computed date (e.g. easter=2025-04-27 or lent=2025-03-01). Repeatable."`
+	Summary   bool     `name:"summary"   help:"Only print the per-season summary."`
+	Format    string   `name:"format"    help:"The output format."                                                            default:"table" enum:"table,json"`
+}
+
+func (c *CoverageCmd) Run() error {
+	if _, err := calendar.ParseYear(c.Year); err != nil {
+		cliutil.PrintError(fmt.Sprintf("Unsupported year: %s", c.Year))
+		return err
+	}
+
+	plans, err := loadPlanSet(c.Plan, mergeOverride)
+	if err != nil {
+		return err
+	}
+
+	tradition := calendar.CalendarTradition(c.Tradition)
+	ce, err := anchorEngine(tradition, plans.plans, c.Anchors, c.Format == "json")
+	if err != nil {
+		return err
+	}
+
+	days, err := ce.GenerateRomanCalendar(c.Year, tradition)
+	if err != nil {
+		cliutil.PrintError("Unable to generate calendar")
+		return err
+	}
+
+	report, err := compile.Coverage(ce, days, *plans.plans[0])
+	if err != nil {
+		cliutil.PrintError("Unable to compile calendar and plan into entries")
+		return err
+	}
+
+	if c.Format == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			cliutil.PrintError("Unable to encode coverage as JSON")
+			return err
+		}
+		return nil
+	}
+
+	if !c.Summary {
+		printCoverageDays(report.Days)
+		fmt.Println()
+	}
+	printCoverageSummary(report.Seasons)
+
+	if len(report.MissingSeasons) > 0 {
+		fmt.Println()
+		cliutil.PrintWarning(fmt.Sprintf(
+			"The plan has no entries for %s",
+			strings.Join(generic.Map(report.MissingSeasons, calendar.LiturgicalSeason.String), ", "),
+		))
+	}
+	if defaults := len(report.DefaultDays()); defaults > 0 {
+		cliutil.PrintWarning(fmt.Sprintf("%d of %d days fall to the plan's defaults", defaults, len(report.Days)))
+	}
+
+	return nil
+}
+
+// printCoverageDays prints one row per day with the part of the plan that resolved it.
+func printCoverageDays(days []compile.DayCoverage) {
+	rows := [][]string{{"Date", "Season", "Week", "Weekday", "Resolved by", "Key"}}
+	for _, day := range days {
+		rows = append(rows, []string{
+			day.Date,
+			day.Season.String(),
+			strconv.Itoa(day.SeasonWeek),
+			day.Weekday.String(),
+			string(day.Resolution),
+			day.ResolvedBy,
+		})
+	}
+	cliutil.PrintTable(rows)
+}
+
+// printCoverageSummary prints one row per season with its days counted by resolution, in the order the plan tries
+// them.
+func printCoverageSummary(seasons []compile.SeasonCoverage) {
+	header := []string{"Season", "Planned", "Days"}
+	for _, resolution := range plan.Resolutions {
+		header = append(header, string(resolution))
+	}
+	rows := [][]string{header}
+	for _, season := range seasons {
+		row := []string{season.Season.String(), strconv.FormatBool(season.Planned), strconv.Itoa(season.Days)}
+		for _, resolution := range plan.Resolutions {
+			row = append(row, strconv.Itoa(season.Resolved[resolution]))
+		}
+		rows = append(rows, row)
+	}
+	cliutil.PrintTable(rows)
+}
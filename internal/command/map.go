@@ -0,0 +1,66 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/julianstephens/go-utils/cliutil"
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+)
+
+type MapCmd struct {
+	Dates     []string `arg:""           help:"The dates to carry to another year (e.g. 2026-02-25)."`
+	Year      string   `name:"year"      help:"The year to carry the dates to (326-9999)."                     required:""`
+	Tradition string   `name:"tradition" help:"The liturgical tradition to match days in."                      default:"roman" enum:"roman,ambrosian,anglican,lutheran,coptic,ethiopian"`
+	Format    string   `name:"format"    help:"The output format."                                             default:"table" enum:"table,json"`
+}
+
+func (c *MapCmd) Run() error {
+	year, err := calendar.ParseYear(c.Year)
+	if err != nil {
+		cliutil.PrintError("Invalid year")
+		return err
+	}
+
+	ce := calendar.NewCalendarEngine()
+	mappings := make([]*calendar.DayMapping, 0, len(c.Dates))
+	for _, date := range c.Dates {
+		mapping, err := ce.MapDay(date, year, calendar.CalendarTradition(c.Tradition))
+		if err != nil {
+			cliutil.PrintError(fmt.Sprintf("Unable to map %s", date))
+			return err
+		}
+		mappings = append(mappings, mapping)
+	}
+
+	if c.Format == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(mappings); err != nil {
+			cliutil.PrintError("Unable to encode mappings as JSON")
+			return err
+		}
+		return nil
+	}
+
+	rows := [][]string{{"Date", "Liturgical day", "Maps to", "Celebration"}}
+	for _, mapping := range mappings {
+		if !mapping.Found {
+			rows = append(rows, []string{mapping.Source.Date, describeKey(mapping.Key), "-", ""})
+			continue
+		}
+		rows = append(rows, []string{
+			mapping.Source.Date, describeKey(mapping.Key), mapping.Target.Date, mapping.Target.Celebration,
+		})
+	}
+	cliutil.PrintTable(rows)
+
+	for _, mapping := range mappings {
+		if !mapping.Found {
+			cliutil.PrintWarning(mapping.Reason)
+		}
+	}
+
+	return nil
+}
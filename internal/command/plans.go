@@ -0,0 +1,94 @@
+package command
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/julianstephens/go-utils/cliutil"
+	"github.com/julianstephens/go-utils/generic"
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+	"github.com/julianstephens/liturgical-time-index/internal/compile"
+	"github.com/julianstephens/liturgical-time-index/internal/plan"
+)
+
+const (
+	// mergeOverride merges the plans into one, later plans winning rule by rule.
+	mergeOverride = "override"
+	// mergeStack keeps every plan, giving each day one entry per plan.
+	mergeStack = "stack"
+)
+
+// planSet holds the plans of the --plan flags as combined by the --merge strategy. Stacked plans are labelled so
+// the outputs can tell their entries apart.
+type planSet struct {
+	plans  []*plan.Plan
+	labels []string
+}
+
+// loadPlanSet loads and validates the plans at the given paths, combining them by the strategy.
+func loadPlanSet(planPaths []string, strategy string) (*planSet, error) {
+	if strategy != mergeStack || len(planPaths) == 1 {
+		p, err := plan.LoadAndMergePlans(planPaths...)
+		if err != nil {
+			cliutil.PrintError("Unable to load and validate plan file")
+			return nil, err
+		}
+		return &planSet{plans: []*plan.Plan{p}, labels: []string{""}}, nil
+	}
+
+	set := &planSet{labels: planLabels(planPaths)}
+	for _, planPath := range planPaths {
+		p, err := plan.LoadAndValidatePlan(planPath)
+		if err != nil {
+			cliutil.PrintError(fmt.Sprintf("Unable to load and validate plan file %s", planPath))
+			return nil, err
+		}
+		set.plans = append(set.plans, p)
+	}
+	return set, nil
+}
+
+// planLabels names plans by their file name without the extension, or by their path if two share a name.
+func planLabels(planPaths []string) []string {
+	labels := generic.Map(planPaths, func(planPath string) string {
+		return strings.TrimSuffix(filepath.Base(planPath), filepath.Ext(planPath))
+	})
+	seen := make(map[string]bool)
+	for _, label := range labels {
+		if seen[label] {
+			return planPaths
+		}
+		seen[label] = true
+	}
+	return labels
+}
+
+// setLang sets the language of the --lang flag on every plan of the set, replacing the plans' own.
+func (s *planSet) setLang(lang *string) error {
+	if lang == nil {
+		return nil
+	}
+	if _, err := calendar.ParseLocale(*lang); err != nil {
+		cliutil.PrintError(fmt.Sprintf("Invalid language: %s", *lang))
+		return err
+	}
+	for _, p := range s.plans {
+		p.Lang = *lang
+	}
+	return nil
+}
+
+// compile compiles the day with each plan of the set, labelling the entries of stacked plans.
+func (s *planSet) compile(ce *calendar.CalendarEngine, day calendar.DayKey) ([]plan.FormattedEntry, error) {
+	entries := make([]plan.FormattedEntry, 0, len(s.plans))
+	for i, p := range s.plans {
+		entry, err := compile.Compile(ce, day, *p)
+		if err != nil {
+			return nil, err
+		}
+		entry.Plan = s.labels[i]
+		entries = append(entries, *entry)
+	}
+	return entries, nil
+}
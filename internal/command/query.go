@@ -0,0 +1,96 @@
+package command
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/julianstephens/go-utils/cliutil"
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+	"github.com/julianstephens/liturgical-time-index/internal/compile"
+	"github.com/julianstephens/liturgical-time-index/internal/plan"
+	"github.com/julianstephens/liturgical-time-index/internal/query"
+)
+
+type QueryCmd struct {
+	Expr      string   `arg:""           help:"The query expression (e.g. \"season=lent and weekday=fri\")."`
+	Year      *string  `name:"year"      help:"The year to search (326-9999)."                                   xor:"year-from,year-to"`
+	From      *string  `name:"from"      help:"The first date to search (e.g. 2028-01-01). Requires --to."       xor:"year-from"`
+	To        *string  `name:"to"        help:"The last date to search (e.g. 2030-12-31). Requires --from."      xor:"year-to"`
+	Tradition string   `name:"tradition" help:"The liturgical tradition to query."                               default:"roman" enum:"roman,ambrosian,anglican,lutheran,coptic,ethiopian"`
+	Plan      *string  `name:"plan"      help:"A plan file to compile the matching days with. If provided, cues are printed."`
+	Anchors   []string `name:"anchor"    help:"Override a computed date (e.g. easter=2025-04-27 or lent=2025-03-01). Repeatable."`
+}
+
+func (c *QueryCmd) Run() error {
+	q, err := query.Parse(c.Expr)
+	if err != nil {
+		cliutil.PrintError("Invalid query")
+		return err
+	}
+
+	from, to, err := dateRange(c.Year, c.From, c.To)
+	if err != nil {
+		return err
+	}
+
+	var p *plan.Plan
+	var plans []*plan.Plan
+	if c.Plan != nil {
+		p, err = plan.LoadAndValidatePlan(*c.Plan)
+		if err != nil {
+			cliutil.PrintError("Unable to load and validate plan file")
+			return err
+		}
+		plans = append(plans, p)
+	}
+
+	tradition := calendar.CalendarTradition(c.Tradition)
+	ce, err := anchorEngine(tradition, plans, c.Anchors, false)
+	if err != nil {
+		return err
+	}
+	days, err := ce.GenerateCalendarRange(from, to, tradition)
+	if err != nil {
+		cliutil.PrintError("Unable to generate calendar")
+		return err
+	}
+	matches, err := q.Filter(ce, days)
+	if err != nil {
+		cliutil.PrintError("Unable to evaluate query")
+		return err
+	}
+
+	if len(matches) == 0 {
+		cliutil.PrintWarning(fmt.Sprintf("No dates between %s and %s match %q", from, to, c.Expr))
+		return nil
+	}
+
+	header := []string{"Date", "Season", "Week", "Weekday", "Celebration"}
+	if p != nil {
+		header = append(header, "Cue")
+	}
+	rows := [][]string{header}
+	for _, day := range matches {
+		row := []string{
+			day.Date,
+			day.Season.String(),
+			strconv.Itoa(day.SeasonWeek),
+			day.Weekday.String(),
+			day.Celebration,
+		}
+		if p != nil {
+			entry, err := compile.Compile(ce, day, *p)
+			if err != nil {
+				cliutil.PrintError("Unable to compile calendar and plan into entries")
+				return err
+			}
+			row = append(row, entry.Summary())
+		}
+		rows = append(rows, row)
+	}
+	cliutil.PrintTable(rows)
+	fmt.Println()
+	cliutil.PrintInfo(fmt.Sprintf("%d matching days", len(matches)))
+
+	return nil
+}
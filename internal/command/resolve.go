@@ -0,0 +1,57 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/julianstephens/go-utils/cliutil"
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+)
+
+type ResolveCmd struct {
+	Key       []string `arg:""           help:"The season, week and optional weekday to resolve (e.g. lent 3 fri)."`
+	Year      *string  `name:"year"      help:"The year to search (326-9999)."                                      xor:"year-from,year-to"`
+	From      *string  `name:"from"      help:"The first date to search (e.g. 2028-01-01). Requires --to."          xor:"year-from"`
+	To        *string  `name:"to"        help:"The last date to search (e.g. 2030-12-31). Requires --from."         xor:"year-to"`
+	Tradition string   `name:"tradition" help:"The liturgical tradition to resolve the key in."                     default:"roman" enum:"roman,ambrosian,anglican,lutheran,coptic,ethiopian"`
+}
+
+func (c *ResolveCmd) Run() error {
+	key, err := calendar.ParseLiturgicalKey(c.Key)
+	if err != nil {
+		cliutil.PrintError("Invalid liturgical key")
+		return err
+	}
+
+	from, to, err := dateRange(c.Year, c.From, c.To)
+	if err != nil {
+		return err
+	}
+
+	ce := calendar.NewCalendarEngine()
+	days, err := ce.Resolve(key, from, to, calendar.CalendarTradition(c.Tradition))
+	if err != nil {
+		cliutil.PrintError("Unable to resolve liturgical key")
+		return err
+	}
+
+	if len(days) == 0 {
+		cliutil.PrintWarning(fmt.Sprintf("No dates between %s and %s match %s", from, to, describeKey(key)))
+		return nil
+	}
+
+	rows := [][]string{{"Date", "Weekday", "Celebration"}}
+	for _, day := range days {
+		rows = append(rows, []string{day.Date, day.Weekday.String(), day.Celebration})
+	}
+	cliutil.PrintTable(rows)
+
+	return nil
+}
+
+// describeKey renders a liturgical key for messages, e.g. "Friday of week 3 of Lent".
+func describeKey(key calendar.LiturgicalKey) string {
+	if key.Weekday == "" {
+		return fmt.Sprintf("week %d of %s", key.Week, key.Season)
+	}
+	return fmt.Sprintf("%s of week %d of %s", key.Weekday, key.Week, key.Season)
+}
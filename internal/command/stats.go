@@ -0,0 +1,148 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/julianstephens/go-utils/cliutil"
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+)
+
+type StatsCmd struct {
+	Year      string   `name:"year"      help:"The year to report on (326-9999)."                                  required:""`
+	Until     *string  `name:"until"     help:"The last year of a range to report on."`
+	Tradition string   `name:"tradition" help:"The liturgical tradition to report on."                             default:"roman" enum:"roman,ambrosian,anglican,lutheran,coptic,ethiopian"`
+	Options   []string `name:"option"    help:"An engine option for the calendar (e.g. epiphany-sunday). Repeatable."`
+	Anchors   []string `name:"anchor"    help:"Override a computed date (e.g. easter=2025-04-27 or lent=2025-03-01). Repeatable."`
+	Format    string   `name:"format"    help:"The output format."                                                 default:"table" enum:"table,json"`
+}
+
+func (c *StatsCmd) Run() error {
+	first, err := calendar.ParseYear(c.Year)
+	if err != nil {
+		cliutil.PrintError("Invalid year")
+		return err
+	}
+	last := first
+	if c.Until != nil {
+		if last, err = calendar.ParseYear(*c.Until); err != nil {
+			cliutil.PrintError("Invalid --until year")
+			return err
+		}
+		if last < first {
+			cliutil.PrintError("--until must not be before --year")
+			return fmt.Errorf("year range %d-%d is reversed", first, last)
+		}
+	}
+
+	opts := make([]calendar.EngineOption, 0, len(c.Options))
+	for _, name := range c.Options {
+		opt, err := calendar.ParseEngineOption(name)
+		if err != nil {
+			cliutil.PrintError("Invalid engine option")
+			return err
+		}
+		opts = append(opts, opt)
+	}
+	ce, err := anchorEngine(calendar.CalendarTradition(c.Tradition), nil, c.Anchors, c.Format == "json", opts...)
+	if err != nil {
+		return err
+	}
+
+	stats := make([]*calendar.YearStats, 0, last-first+1)
+	for year := first; year <= last; year++ {
+		s, err := ce.Stats(year, calendar.CalendarTradition(c.Tradition))
+		if err != nil {
+			cliutil.PrintError(fmt.Sprintf("Unable to compute statistics for %d", year))
+			return err
+		}
+		stats = append(stats, s)
+	}
+
+	if c.Format == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(stats); err != nil {
+			cliutil.PrintError("Unable to encode statistics as JSON")
+			return err
+		}
+		return nil
+	}
+
+	if len(stats) > 1 {
+		printStatsSummary(stats)
+		return nil
+	}
+	printYearStats(stats[0])
+
+	return nil
+}
+
+// printStatsSummary prints one row per year of a range.
+func printStatsSummary(stats []*calendar.YearStats) {
+	rows := [][]string{{
+		"Year", "Easter", "Days after earliest", "Days before latest",
+		"Sundays after Epiphany", "Sundays after Pentecost", "Ordinary weeks before/after Pentecost",
+	}}
+	for _, s := range stats {
+		rows = append(rows, []string{
+			strconv.Itoa(s.Year),
+			s.Easter.Date,
+			strconv.Itoa(s.Easter.DaysAfterEarliest),
+			strconv.Itoa(s.Easter.DaysBeforeLatest),
+			strconv.Itoa(s.SundaysAfterEpiphany),
+			strconv.Itoa(s.SundaysAfterPentecost),
+			fmt.Sprintf("%d/%d", s.OrdinaryWeeksBeforePentecost, s.OrdinaryWeeksAfterPentecost),
+		})
+	}
+	cliutil.PrintTable(rows)
+}
+
+// printYearStats prints the season lengths, counts and Sunday feasts of a single year.
+func printYearStats(s *calendar.YearStats) {
+	cliutil.PrintInfo(fmt.Sprintf(
+		"Easter %d falls on %s, %s and %s",
+		s.Year, s.Easter.Date,
+		easterDistance(s.Easter.DaysAfterEarliest, "after", "before", "the earliest date ("+s.Easter.Earliest+")"),
+		easterDistance(s.Easter.DaysBeforeLatest, "before", "after", "the latest ("+s.Easter.Latest+")"),
+	))
+	fmt.Println()
+
+	rows := [][]string{{"Season", "Start", "End", "Days", "Weeks"}}
+	for _, run := range s.Seasons {
+		rows = append(rows, []string{
+			run.Season.String(), run.Start, run.End, strconv.Itoa(run.Days), strconv.Itoa(run.Weeks),
+		})
+	}
+	cliutil.PrintTable(rows)
+	fmt.Println()
+
+	cliutil.PrintTable([][]string{
+		{"Count", "Value"},
+		{"Sundays after Epiphany", strconv.Itoa(s.SundaysAfterEpiphany)},
+		{"Sundays after Pentecost", strconv.Itoa(s.SundaysAfterPentecost)},
+		{"Ordinary weeks before Pentecost", strconv.Itoa(s.OrdinaryWeeksBeforePentecost)},
+		{"Ordinary weeks after Pentecost", strconv.Itoa(s.OrdinaryWeeksAfterPentecost)},
+	})
+
+	if len(s.SundayFeasts) == 0 {
+		return
+	}
+	fmt.Println()
+	rows = [][]string{{"Sunday", "Feast"}}
+	for _, observance := range s.SundayFeasts {
+		rows = append(rows, []string{observance.Date, observance.Name})
+	}
+	cliutil.PrintTable(rows)
+}
+
+// easterDistance phrases how far Easter falls from one end of its range. An anchor override can move Easter out of
+// the range, so a negative distance is phrased from the other side.
+func easterDistance(days int, inside, outside, bound string) string {
+	if days < 0 {
+		return fmt.Sprintf("%d days %s %s", -days, outside, bound)
+	}
+	return fmt.Sprintf("%d days %s %s", days, inside, bound)
+}
@@ -8,20 +8,24 @@ import (
 	"github.com/julianstephens/go-utils/cliutil"
 	"github.com/julianstephens/liturgical-time-index/internal"
 	"github.com/julianstephens/liturgical-time-index/internal/calendar"
-	"github.com/julianstephens/liturgical-time-index/internal/compile"
 	"github.com/julianstephens/liturgical-time-index/internal/plan"
 )
 
 type TodayCmd struct {
-	Date      *string `name:"date"      help:"The date to get the entry for (e.g. 2024-12-25). If not provided, defaults to today's date."`
-	Tradition string  `name:"tradition" help:"The liturgical tradition to get the entry for."                                              default:"roman"       enum:"roman"`
-	Plan      string  `name:"plan"      help:"The path to the plan file to use for looking up the entry."                                  default:"./plan.yaml"`
+	Date      *string  `name:"date"      help:"The date to get the entry for (e.g. 2024-12-25). If not provided, defaults to today's date."`
+	Tradition string   `name:"tradition" help:"The liturgical tradition to get the entry for."                                              default:"roman"       enum:"roman,ambrosian,anglican,lutheran,coptic,ethiopian"`
+	Plan      []string `name:"plan"      help:"The path to the plan file to use for looking up the entry. Repeatable."                      default:"./plan.yaml"`
+	Merge     string   `name:"merge"     help:"How to combine several plans: override (later plans win per rule) or stack."                 default:"override"    enum:"override,stack"`
+	Anchors   []string `name:"anchor"    help:"Override a computed date (e.g. easter=2025-04-27 or lent=2025-03-01). Repeatable."`
+	Lang      *string  `name:"lang"      help:"The language to choose cues and name seasons and weekdays in (e.g. la, es, de, fr, it)."`
 }
 
 func (c *TodayCmd) Run() error {
-	p, err := plan.LoadAndValidatePlan(c.Plan)
+	plans, err := loadPlanSet(c.Plan, c.Merge)
 	if err != nil {
-		cliutil.PrintError("Unable to load and validate plan file")
+		return err
+	}
+	if err := plans.setLang(c.Lang); err != nil {
 		return err
 	}
 
@@ -36,7 +40,10 @@ func (c *TodayCmd) Run() error {
 		return fmt.Errorf("invalid date format: %s. expected format: %s", *c.Date, internal.DateFormat)
 	}
 
-	ce := calendar.NewCalendarEngine()
+	ce, err := anchorEngine(calendar.CalendarTradition(c.Tradition), plans.plans, c.Anchors, false)
+	if err != nil {
+		return err
+	}
 	calendar, err := ce.GenerateRomanCalendar(
 		strconv.Itoa(formattedDate.Year()),
 		calendar.CalendarTradition(c.Tradition),
@@ -46,36 +53,70 @@ func (c *TodayCmd) Run() error {
 		return err
 	}
 
-	var entry *plan.FormattedEntry
+	var entries []plan.FormattedEntry
 	for _, day := range calendar {
 		if day.Date == *c.Date {
-			e, err := compile.Compile(day, *p)
+			entries, err = plans.compile(ce, day)
 			if err != nil {
 				cliutil.PrintError("Unable to compile calendar and plan into entry")
 				return err
 			}
-			entry = e
 			break
 		}
 	}
 
-	if entry == nil {
+	if len(entries) == 0 {
 		cliutil.PrintError(fmt.Sprintf("No entry found for date: %s", *c.Date))
 		return fmt.Errorf("no entry found for date: %s", *c.Date)
 	}
+	key := entries[0].Key
 
 	fmt.Println()
 	cliutil.PrintColored(*c.Date, cliutil.ColorBlue)
+	if key.JulianDate != "" {
+		cliutil.PrintColored(fmt.Sprintf("Julian: %s", key.JulianDate), cliutil.ColorBlue)
+	}
+	if key.NativeDate != "" {
+		cliutil.PrintColored(key.NativeDate, cliutil.ColorBlue)
+	}
+	lang := entries[0].Lang
 	cliutil.PrintColored(
-		fmt.Sprintf("Season: %s, Week: %d, Weekday: %s", entry.Key.Season, entry.Key.SeasonWeek, entry.Key.Weekday),
+		fmt.Sprintf(
+			"Season: %s, Week: %d, Weekday: %s",
+			key.Season.Localized(lang),
+			key.SeasonWeek,
+			key.Weekday.Localized(lang),
+		),
 		cliutil.ColorBold,
 	)
+	if key.Celebration != "" {
+		celebration := key.Celebration
+		if key.Colour != "" {
+			celebration += fmt.Sprintf(" (%s)", key.Colour)
+		}
+		cliutil.PrintColored(celebration, cliutil.ColorBold)
+	}
 	fmt.Println("---")
-	fmt.Println()
-	cliutil.PrintColored(entry.Cue, cliutil.ColorMagenta)
-	fmt.Println()
-	for _, rb := range entry.Rb {
-		fmt.Println("- " + rb.String())
+	for _, entry := range entries {
+		fmt.Println()
+		if entry.Plan != "" {
+			cliutil.PrintColored(entry.Plan, cliutil.ColorBold)
+		}
+		if entry.Cue != "" {
+			cliutil.PrintColored(entry.Cue, cliutil.ColorMagenta)
+			fmt.Println()
+		}
+		for _, rb := range entry.Rb {
+			fmt.Println("- " + rb.String())
+		}
+		for _, slot := range entry.Slots {
+			fmt.Println()
+			cliutil.PrintColored(slot.Label(), cliutil.ColorBold)
+			cliutil.PrintColored(slot.Cue, cliutil.ColorMagenta)
+			for _, rb := range slot.Rb {
+				fmt.Println("- " + rb.String())
+			}
+		}
 	}
 	fmt.Println()
 
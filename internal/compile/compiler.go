@@ -6,48 +6,132 @@ import (
 )
 
 // Compile compiles a plan for a given day key, applying defaults and fallbacks as necessary.
-func Compile(key calendar.DayKey, p plan.Plan) (*plan.FormattedEntry, error) {
-	defaults := p.Defaults
-	formattedDefaults, err := defaults.Validate()
+// Entries are resolved from the most specific to the least: the plan's entry for the exact date, its entry for a
+// day reckoned from an anchor (easter+7), its entry for the month and day in every year, its entry for a day of the
+// civil month (day-15, first-fri, last-sun), the first of its rules whose when expression matches, the season's
+// week plans (weekday, then fallback), the season's weekday, the season's fallback, and finally the plan's
+// defaults. Rules can test the tags of the entry the season would give. A weekday key naming fewer days wins, so
+// "fri" is taken before "mon-fri". The season's plan includes the entries of its group and of the season it
+// inherits from, which its own override. A rotating entry then picks one of its entries for the day, its cue is
+// chosen in the plan's language, and the placeholders of the cue ("Day {{.DayOfSeason}} of {{.Season}}") are
+// expanded. The engine answers questions about the day's place in the calendar, such as Easter's date or which
+// week is the last of its season.
+func Compile(ce *calendar.CalendarEngine, key calendar.DayKey, p plan.Plan) (*plan.FormattedEntry, error) {
+	if _, err := p.Defaults.Validate(); err != nil {
+		return nil, err
+	}
+
+	if entry, ok := p.Dates[key.Date]; ok {
+		return compileEntry(ce, key, p.Lang, plan.MatchedEntry{PlanEntry: entry, Key: key.Date}, plan.ResolvedDate)
+	}
+	entry, ok, err := p.MovableEntry(ce, key.Date, key.Tradition)
 	if err != nil {
 		return nil, err
 	}
-	defaultEntry := &plan.FormattedEntry{
-		Key:  key,
-		Cue:  formattedDefaults.Cue,
-		Rb:   formattedDefaults.Rb,
-		Tags: formattedDefaults.Tags,
+	if ok {
+		return compileEntry(ce, key, p.Lang, entry, plan.ResolvedMovable)
+	}
+	if entry, ok := p.DateEntry(key.Date); ok {
+		return compileEntry(ce, key, p.Lang, entry, plan.ResolvedAnnual)
+	}
+	if entry, ok := p.MonthlyEntry(key.Date); ok {
+		return compileEntry(ce, key, p.Lang, entry, plan.ResolvedMonthly)
 	}
 
-	seasonPlan, ok := p.Seasons[string(key.Season)]
+	seasonEntry, err := compileSeason(ce, key, p)
+	if err != nil || len(p.Rules) == 0 {
+		return seasonEntry, err
+	}
+	var tags []string
+	if seasonEntry.Tags != nil {
+		tags = *seasonEntry.Tags
+	}
+	entry, ok, err = p.RuleEntry(ce, key, tags)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return compileEntry(ce, key, p.Lang, entry, plan.ResolvedRule)
+	}
+	return seasonEntry, nil
+}
+
+// compileSeason compiles the entry the season gives the day: its week plans, weekday and fallback, or the plan's
+// defaults.
+func compileSeason(
+	ce *calendar.CalendarEngine,
+	key calendar.DayKey,
+	p plan.Plan,
+) (*plan.FormattedEntry, error) {
+	seasonPlan, ok, err := p.SeasonPlan(string(key.Season))
+	if err != nil {
+		return nil, err
+	}
 	if !ok {
-		return defaultEntry, nil
+		return compileEntry(ce, key, p.Lang, plan.MatchedEntry{PlanEntry: p.Defaults}, plan.ResolvedDefault)
 	}
 
-	weekday, ok := seasonPlan.Weekdays[string(key.Weekday)]
+	weekPlans, err := seasonPlan.MatchWeeks(key.SeasonWeek, func() (int, error) {
+		return ce.LastSeasonWeek(key)
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, weekPlan := range weekPlans {
+		entry, ok, err := weekPlan.Weekday(key.Weekday)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			entry.Key = weekPlan.Key + "/" + entry.Key
+			return compileEntry(ce, key, p.Lang, entry, plan.ResolvedWeek)
+		}
+		if weekPlan.Fallback != nil {
+			fallback := plan.MatchedEntry{PlanEntry: *weekPlan.Fallback, Key: weekPlan.Key}
+			return compileEntry(ce, key, p.Lang, fallback, plan.ResolvedWeekFallback)
+		}
+	}
+
+	weekday, ok, err := seasonPlan.Weekday(key.Weekday)
+	if err != nil {
+		return nil, err
+	}
 	if !ok {
 		if seasonPlan.Fallback != nil {
-			fallback, err := seasonPlan.Fallback.Validate()
-			if err != nil {
-				return nil, err
-			}
-
-			return &plan.FormattedEntry{
-				Key:  key,
-				Cue:  fallback.Cue,
-				Rb:   fallback.Rb,
-				Tags: fallback.Tags,
-			}, nil
+			fallback := plan.MatchedEntry{PlanEntry: *seasonPlan.Fallback}
+			return compileEntry(ce, key, p.Lang, fallback, plan.ResolvedFallback)
 		}
-
-		return defaultEntry, nil
+		return compileEntry(ce, key, p.Lang, plan.MatchedEntry{PlanEntry: p.Defaults}, plan.ResolvedDefault)
 	}
 
-	formattedEntry, err := weekday.Validate()
+	return compileEntry(ce, key, p.Lang, weekday, plan.ResolvedWeekday)
+}
+
+// compileEntry picks the entry for the day from a rotating entry, then validates it and formats it for the day,
+// choosing its cues in the language and expanding their placeholders. The formatted entry records how the plan
+// resolved the day and by which key.
+func compileEntry(
+	ce *calendar.CalendarEngine,
+	key calendar.DayKey,
+	lang string,
+	matched plan.MatchedEntry,
+	resolution plan.Resolution,
+) (*plan.FormattedEntry, error) {
+	entry, err := matched.Rotate(key, func() (int, error) {
+		return ce.DayOfSeason(key)
+	})
 	if err != nil {
 		return nil, err
 	}
+	formattedEntry, err := entry.Validate()
+	if err != nil {
+		return nil, err
+	}
+	if err := formattedEntry.ExpandCues(ce, key, lang); err != nil {
+		return nil, err
+	}
 	formattedEntry.Key = key
-
+	formattedEntry.Resolution = resolution
+	formattedEntry.ResolvedBy = matched.Key
 	return formattedEntry, nil
 }
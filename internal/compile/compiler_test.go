@@ -2,6 +2,7 @@ package compile_test
 
 import (
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/julianstephens/liturgical-time-index/internal/calendar"
@@ -100,7 +101,7 @@ func TestBoundaryCondition_AshWednesdayToHolySaturday(t *testing.T) {
 			}
 
 			// Compile entry
-			entry, err := compile.Compile(*dayKey, testPlan)
+			entry, err := compile.Compile(ce, *dayKey, testPlan)
 			if err != nil {
 				t.Fatalf("Compile failed: %v", err)
 			}
@@ -209,7 +210,7 @@ func TestBoundaryCondition_EasterToPentecost(t *testing.T) {
 				t.Errorf("Expected weekday %s, got %s", tc.expectedWeekday, dayKey.Weekday)
 			}
 
-			entry, err := compile.Compile(*dayKey, testPlan)
+			entry, err := compile.Compile(ce, *dayKey, testPlan)
 			if err != nil {
 				t.Fatalf("Compile failed: %v", err)
 			}
@@ -348,7 +349,7 @@ func TestBoundaryCondition_LateAdventToEpiphany(t *testing.T) {
 				t.Errorf("Expected weekday %s, got %s", tc.expectedWeekday, dayKey.Weekday)
 			}
 
-			entry, err := compile.Compile(*dayKey, testPlan)
+			entry, err := compile.Compile(ce, *dayKey, testPlan)
 			if err != nil {
 				t.Fatalf("Compile failed: %v", err)
 			}
@@ -411,7 +412,7 @@ func TestMatchingPrecedence_WeekdayOverride(t *testing.T) {
 				Weekday:    tc.weekday,
 			}
 
-			entry, err := compile.Compile(dayKey, testPlan)
+			entry, err := compile.Compile(calendar.NewCalendarEngine(), dayKey, testPlan)
 			if err != nil {
 				t.Fatalf("Compile failed: %v", err)
 			}
@@ -476,7 +477,7 @@ func TestMatchingPrecedence_SeasonFallback(t *testing.T) {
 				Weekday:    tc.weekday,
 			}
 
-			entry, err := compile.Compile(dayKey, testPlan)
+			entry, err := compile.Compile(calendar.NewCalendarEngine(), dayKey, testPlan)
 			if err != nil {
 				t.Fatalf("Compile failed: %v", err)
 			}
@@ -497,6 +498,654 @@ func TestMatchingPrecedence_SeasonFallback(t *testing.T) {
 	}
 }
 
+// TestMatchingPrecedence_WeekOverride verifies that week plans take precedence over the season's entries, with
+// single weeks before "last" and "last" before ranges, and that a week plan without the weekday falls through.
+func TestMatchingPrecedence_WeekOverride(t *testing.T) {
+	testPlan := createWeekOverridePlan()
+
+	// For 2025: Lent weeks are counted from Ash Wednesday (2025-03-05), so the last week of Lent, week 7, is the
+	// single day 2025-04-16 before the Triduum
+	testCases := []struct {
+		date        string
+		expectedCue string
+		description string
+	}{
+		{date: "2025-03-07", expectedCue: "First Friday of Lent", description: "Single week weekday entry"},
+		{date: "2025-03-10", expectedCue: "Early Lent", description: "Single week without the weekday falls through"},
+		{date: "2025-03-14", expectedCue: "Early Lent", description: "Range fallback beats season weekday"},
+		{date: "2025-03-21", expectedCue: "Friday in Lent", description: "Season weekday outside the week plans"},
+		{date: "2025-03-31", expectedCue: "Lent", description: "Season fallback outside the week plans"},
+		{date: "2025-04-15", expectedCue: "Lent", description: "Week before the last week"},
+		{date: "2025-04-16", expectedCue: "Spy Wednesday", description: "Last week weekday entry"},
+	}
+
+	ce := calendar.NewCalendarEngine()
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			dayKey, err := ce.GetRomanDay(tc.date, calendar.RomanCalendar)
+			if err != nil {
+				t.Fatalf("Failed to get Roman day for %s: %v", tc.date, err)
+			}
+
+			entry, err := compile.Compile(ce, *dayKey, testPlan)
+			if err != nil {
+				t.Fatalf("Compile failed: %v", err)
+			}
+
+			if entry.Cue != tc.expectedCue {
+				t.Errorf("Expected cue %q for %s (week %d), got %q",
+					tc.expectedCue, tc.date, dayKey.SeasonWeek, entry.Cue)
+			}
+		})
+	}
+}
+
+// TestMatchingPrecedence_DateOverride verifies that date entries take precedence over the season's entries, with
+// an exact date before a movable one and a movable date before an annual one.
+func TestMatchingPrecedence_DateOverride(t *testing.T) {
+	testPlan := createWeekOverridePlan()
+	testPlan.Dates = map[string]plan.PlanEntry{
+		"2025-03-07": {Cue: "Retreat day", Rb: []string{"RB 49.7"}},
+		"03-07":      {Cue: "Anniversary", Rb: []string{"RB 49.8"}},
+		"03-06":      {Cue: "Annual Thursday", Rb: []string{"RB 49.9"}},
+	}
+	testPlan.Movable = map[string]plan.PlanEntry{
+		"ash-wednesday":   {Cue: "Ash Wednesday", Rb: []string{"RB 49.10"}},
+		"ash-wednesday+1": {Cue: "Thursday after Ash Wednesday", Rb: []string{"RB 49.11"}},
+		"ash-wednesday+2": {Cue: "Friday after Ash Wednesday", Rb: []string{"RB 49.12"}},
+	}
+
+	testCases := []struct {
+		date        string
+		expectedCue string
+		description string
+	}{
+		{date: "2025-03-07", expectedCue: "Retreat day", description: "Exact date beats week plans"},
+		{date: "2026-03-07", expectedCue: "Anniversary", description: "Annual date in another year"},
+		{date: "2025-03-05", expectedCue: "Ash Wednesday", description: "Movable date beats week plans"},
+		{date: "2025-03-06", expectedCue: "Thursday after Ash Wednesday", description: "Movable beats annual date"},
+		{date: "2026-03-06", expectedCue: "Annual Thursday", description: "Annual date when the anchor moves"},
+		{date: "2025-03-08", expectedCue: "Early Lent", description: "Other days use the season"},
+	}
+
+	ce := calendar.NewCalendarEngine()
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			dayKey, err := ce.GetRomanDay(tc.date, calendar.RomanCalendar)
+			if err != nil {
+				t.Fatalf("Failed to get Roman day for %s: %v", tc.date, err)
+			}
+
+			entry, err := compile.Compile(ce, *dayKey, testPlan)
+			if err != nil {
+				t.Fatalf("Compile failed: %v", err)
+			}
+
+			if entry.Cue != tc.expectedCue {
+				t.Errorf("Expected cue %q for %s, got %q", tc.expectedCue, tc.date, entry.Cue)
+			}
+			if entry.Key.Date != tc.date {
+				t.Errorf("Expected entry for %s, got %s", tc.date, entry.Key.Date)
+			}
+		})
+	}
+}
+
+// TestRotation verifies that rotating entries cycle through their entries by day or week of the season, and
+// that random rotation is stable for a date.
+func TestRotation(t *testing.T) {
+	entries := []plan.PlanEntry{
+		{Cue: "First", Rb: []string{"RB 49.1"}},
+		{Cue: "Second", Rb: []string{"RB 49.2"}},
+		{Cue: "Third", Rb: []string{"RB 49.3"}},
+	}
+	lentTag := "lent"
+	rotatingPlan := func(rotation plan.Rotation) plan.Plan {
+		return plan.Plan{
+			Version:  1,
+			Work:     "Rotation Test Plan",
+			Witness:  "test",
+			Defaults: plan.PlanEntry{Cue: "Default Reading", Rb: []string{"RB 1"}},
+			Seasons: map[string]plan.SeasonPlan{
+				string(calendar.Lent): {
+					Fallback: &plan.PlanEntry{Rotation: rotation, Entries: entries, Tags: &[]string{lentTag}},
+				},
+			},
+		}
+	}
+
+	// For 2025: Ash Wednesday is 2025-03-05, day 1 of Lent and of week 1
+	testCases := []struct {
+		rotation    plan.Rotation
+		date        string
+		expectedCue string
+		description string
+	}{
+		{rotation: plan.RotateSequential, date: "2025-03-05", expectedCue: "First", description: "Sequential day 1"},
+		{rotation: plan.RotateSequential, date: "2025-03-06", expectedCue: "Second", description: "Sequential day 2"},
+		{rotation: plan.RotateSequential, date: "2025-03-08", expectedCue: "First", description: "Sequential wraps"},
+		{rotation: "", date: "2025-03-07", expectedCue: "Third", description: "Sequential by default"},
+		{rotation: plan.RotateWeekly, date: "2025-03-11", expectedCue: "First", description: "Weekly week 1"},
+		{rotation: plan.RotateWeekly, date: "2025-03-12", expectedCue: "Second", description: "Weekly week 2"},
+		{rotation: plan.RotateWeekly, date: "2025-03-26", expectedCue: "First", description: "Weekly wraps"},
+	}
+
+	ce := calendar.NewCalendarEngine()
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			dayKey, err := ce.GetRomanDay(tc.date, calendar.RomanCalendar)
+			if err != nil {
+				t.Fatalf("Failed to get Roman day for %s: %v", tc.date, err)
+			}
+
+			entry, err := compile.Compile(ce, *dayKey, rotatingPlan(tc.rotation))
+			if err != nil {
+				t.Fatalf("Compile failed: %v", err)
+			}
+
+			if entry.Cue != tc.expectedCue {
+				t.Errorf("Expected cue %q for %s, got %q", tc.expectedCue, tc.date, entry.Cue)
+			}
+			if entry.Tags == nil || (*entry.Tags)[0] != lentTag {
+				t.Errorf("Expected the rotating entry's tags, got %v", entry.Tags)
+			}
+		})
+	}
+
+	t.Run("Random is stable", func(t *testing.T) {
+		dayKey, err := ce.GetRomanDay("2025-03-20", calendar.RomanCalendar)
+		if err != nil {
+			t.Fatalf("Failed to get Roman day: %v", err)
+		}
+		first, err := compile.Compile(ce, *dayKey, rotatingPlan(plan.RotateRandom))
+		if err != nil {
+			t.Fatalf("Compile failed: %v", err)
+		}
+		second, err := compile.Compile(ce, *dayKey, rotatingPlan(plan.RotateRandom))
+		if err != nil {
+			t.Fatalf("Compile failed: %v", err)
+		}
+		if first.Cue != second.Cue {
+			t.Errorf("Expected the same cue for the same date, got %q and %q", first.Cue, second.Cue)
+		}
+	})
+}
+
+// TestSlots verifies that an entry's slots are compiled in order of their time of day, with rotating slots
+// rotated for the day.
+func TestSlots(t *testing.T) {
+	testPlan := plan.Plan{
+		Version:  1,
+		Work:     "Slots Test Plan",
+		Witness:  "test",
+		Defaults: plan.PlanEntry{Cue: "Default Reading", Rb: []string{"RB 1"}},
+		Seasons: map[string]plan.SeasonPlan{
+			string(calendar.Lent): {
+				Fallback: &plan.PlanEntry{
+					Slots: map[string]plan.SlotEntry{
+						"evening": {PlanEntry: plan.PlanEntry{Cue: "Chapter", Rb: []string{"RB 42.1"}}},
+						"morning": {PlanEntry: plan.PlanEntry{
+							Rotation: plan.RotateSequential,
+							Entries: []plan.PlanEntry{
+								{Cue: "Lectio", Rb: []string{"RB 48.1"}},
+								{Cue: "Psalms", Rb: []string{"RB 18.1"}},
+							},
+						}},
+					},
+				},
+			},
+		},
+	}
+
+	ce := calendar.NewCalendarEngine()
+	// For 2025: Ash Wednesday, 2025-03-05, is day 1 of Lent
+	for date, expectedMorning := range map[string]string{"2025-03-05": "Lectio", "2025-03-06": "Psalms"} {
+		dayKey, err := ce.GetRomanDay(date, calendar.RomanCalendar)
+		if err != nil {
+			t.Fatalf("Failed to get Roman day for %s: %v", date, err)
+		}
+
+		entry, err := compile.Compile(ce, *dayKey, testPlan)
+		if err != nil {
+			t.Fatalf("Compile failed: %v", err)
+		}
+
+		if entry.Cue != "" || len(entry.Slots) != 2 {
+			t.Fatalf("Expected 2 slots and no cue for %s, got %+v", date, entry)
+		}
+		if entry.Slots[0].Name != "morning" || entry.Slots[0].Cue != expectedMorning {
+			t.Errorf("Expected morning %q first for %s, got %+v", expectedMorning, date, entry.Slots[0])
+		}
+		if entry.Slots[1].Name != "evening" || entry.Slots[1].Time != "18:00" {
+			t.Errorf("Expected evening at 18:00 second for %s, got %+v", date, entry.Slots[1])
+		}
+	}
+}
+
+// TestSeasonGroups verifies that seasons take entries from their group and from the season they inherit from,
+// with their own entries taking precedence.
+func TestSeasonGroups(t *testing.T) {
+	testPlan := plan.Plan{
+		Version:  1,
+		Work:     "Season Groups Test Plan",
+		Witness:  "test",
+		Defaults: plan.PlanEntry{Cue: "Default Reading", Rb: []string{"RB 1"}},
+		Groups:   map[string][]string{"penitential": {"advent", "lent"}},
+		Seasons: map[string]plan.SeasonPlan{
+			"penitential": {
+				Weekdays: map[string]plan.PlanEntry{"fri": {Cue: "Penitential Friday", Rb: []string{"RB 49.4"}}},
+				Fallback: &plan.PlanEntry{Cue: "Penitential", Rb: []string{"RB 49.1"}},
+			},
+			string(calendar.Lent): {
+				Weekdays: map[string]plan.PlanEntry{"wed": {Cue: "Lenten Wednesday", Rb: []string{"RB 49.6"}}},
+			},
+			string(calendar.Triduum): {
+				Inherits: string(calendar.Lent),
+				Weekdays: map[string]plan.PlanEntry{"thu": {Cue: "Holy Thursday", Rb: []string{"RB 35.1"}}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		date        string
+		expectedCue string
+		description string
+	}{
+		{date: "2025-12-05", expectedCue: "Penitential Friday", description: "Advent takes the group weekday"},
+		{date: "2025-12-06", expectedCue: "Penitential", description: "Advent takes the group fallback"},
+		{date: "2025-03-05", expectedCue: "Lenten Wednesday", description: "Lent overrides the group weekday"},
+		{date: "2025-03-07", expectedCue: "Penitential Friday", description: "Lent takes the group weekday"},
+		{date: "2025-04-17", expectedCue: "Holy Thursday", description: "Triduum's own weekday"},
+		{date: "2025-04-18", expectedCue: "Penitential Friday", description: "Triduum inherits from Lent"},
+		{date: "2025-05-02", expectedCue: "Default Reading", description: "Eastertide is not planned"},
+	}
+
+	ce := calendar.NewCalendarEngine()
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			dayKey, err := ce.GetRomanDay(tc.date, calendar.RomanCalendar)
+			if err != nil {
+				t.Fatalf("Failed to get Roman day for %s: %v", tc.date, err)
+			}
+
+			entry, err := compile.Compile(ce, *dayKey, testPlan)
+			if err != nil {
+				t.Fatalf("Compile failed: %v", err)
+			}
+
+			if entry.Cue != tc.expectedCue {
+				t.Errorf("Expected cue %q for %s, got %q", tc.expectedCue, tc.date, entry.Cue)
+			}
+		})
+	}
+}
+
+// TestWeekdayRanges verifies that range and group weekday keys match, that a single day wins over a range, and
+// that a season's range replaces the days its group gives for that range.
+func TestWeekdayRanges(t *testing.T) {
+	testPlan := plan.Plan{
+		Version:  1,
+		Work:     "Weekday Ranges Test Plan",
+		Witness:  "test",
+		Defaults: plan.PlanEntry{Cue: "Default Reading", Rb: []string{"RB 1"}},
+		Groups:   map[string][]string{"penitential": {"advent", "lent"}},
+		Seasons: map[string]plan.SeasonPlan{
+			"penitential": {
+				Weekdays: map[string]plan.PlanEntry{
+					"fri":     {Cue: "Penitential Friday", Rb: []string{"RB 49.4"}},
+					"weekend": {Cue: "Penitential weekend", Rb: []string{"RB 49.5"}},
+				},
+				Fallback: &plan.PlanEntry{Cue: "Penitential", Rb: []string{"RB 49.1"}},
+			},
+			string(calendar.Lent): {
+				Weekdays: map[string]plan.PlanEntry{"mon-fri": {Cue: "Lenten feria", Rb: []string{"RB 49.6"}}},
+			},
+			string(calendar.Ordinary): {
+				Weekdays: map[string]plan.PlanEntry{
+					"weekdays": {Cue: "Feria", Rb: []string{"RB 48.1"}},
+					"wed":      {Cue: "Wednesday", Rb: []string{"RB 48.2"}},
+					"sat,sun":  {Cue: "Weekend", Rb: []string{"RB 48.22"}},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		date        string
+		expectedCue string
+		description string
+	}{
+		{date: "2025-07-14", expectedCue: "Feria", description: "Ordinary Monday takes the weekdays group"},
+		{date: "2025-07-16", expectedCue: "Wednesday", description: "A single day wins over a range"},
+		{date: "2025-07-19", expectedCue: "Weekend", description: "A list of days matches"},
+		{date: "2025-12-05", expectedCue: "Penitential Friday", description: "Advent takes the group's friday"},
+		{date: "2025-12-06", expectedCue: "Penitential weekend", description: "Advent takes the group's weekend"},
+		{date: "2025-03-07", expectedCue: "Lenten feria", description: "Lent's range replaces the group's friday"},
+		{date: "2025-03-08", expectedCue: "Penitential weekend", description: "Lent keeps the group's weekend"},
+	}
+
+	ce := calendar.NewCalendarEngine()
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			dayKey, err := ce.GetRomanDay(tc.date, calendar.RomanCalendar)
+			if err != nil {
+				t.Fatalf("Failed to get Roman day for %s: %v", tc.date, err)
+			}
+
+			entry, err := compile.Compile(ce, *dayKey, testPlan)
+			if err != nil {
+				t.Fatalf("Compile failed: %v", err)
+			}
+
+			if entry.Cue != tc.expectedCue {
+				t.Errorf("Expected cue %q for %s, got %q", tc.expectedCue, tc.date, entry.Cue)
+			}
+		})
+	}
+}
+
+// TestMatchingPrecedence_MonthlyOverride verifies that monthly rules take precedence over the season rules and
+// give way to date entries.
+func TestMatchingPrecedence_MonthlyOverride(t *testing.T) {
+	testPlan := createWeekOverridePlan()
+	testPlan.Dates = map[string]plan.PlanEntry{
+		"03-07": {Cue: "Annual date", Rb: []string{"RB 73.1"}},
+	}
+	testPlan.Monthly = map[string]plan.PlanEntry{
+		"first-fri": {Cue: "First Friday", Rb: []string{"RB 49.4"}},
+		"day-14":    {Cue: "Recollection day", Rb: []string{"RB 49.5"}},
+	}
+
+	testCases := []struct {
+		date        string
+		expectedCue string
+		description string
+	}{
+		{date: "2025-03-07", expectedCue: "Annual date", description: "Date entry wins over first Friday"},
+		{date: "2026-03-06", expectedCue: "First Friday", description: "First Friday wins over Lent's friday"},
+		{date: "2025-03-14", expectedCue: "Recollection day", description: "Day of the month wins over Lent's friday"},
+		{date: "2025-03-21", expectedCue: "Friday in Lent", description: "Other Fridays follow the season"},
+	}
+
+	ce := calendar.NewCalendarEngine()
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			dayKey, err := ce.GetRomanDay(tc.date, calendar.RomanCalendar)
+			if err != nil {
+				t.Fatalf("Failed to get Roman day for %s: %v", tc.date, err)
+			}
+
+			entry, err := compile.Compile(ce, *dayKey, testPlan)
+			if err != nil {
+				t.Fatalf("Compile failed: %v", err)
+			}
+
+			if entry.Cue != tc.expectedCue {
+				t.Errorf("Expected cue %q for %s, got %q", tc.expectedCue, tc.date, entry.Cue)
+			}
+		})
+	}
+}
+
+// TestMatchingPrecedence_Rules verifies that the first matching rule takes precedence over the season rules, that
+// rules can test the tags of the season's entry, and that monthly rules take precedence over them.
+func TestMatchingPrecedence_Rules(t *testing.T) {
+	testPlan := createWeekOverridePlan()
+	testPlan.Seasons[string(calendar.Lent)].Fallback.Tags = &[]string{"fast"}
+	testPlan.Monthly = map[string]plan.PlanEntry{
+		"day-14": {Cue: "Recollection day", Rb: []string{"RB 49.5"}},
+	}
+	testPlan.Rules = []plan.Rule{
+		{
+			When:      `season == "lent" && week >= 5 && weekday in ["fri"]`,
+			PlanEntry: plan.PlanEntry{Cue: "Late Lenten Friday", Rb: []string{"RB 49.4"}},
+		},
+		{
+			When:      "tags == fast && weekday == sat",
+			PlanEntry: plan.PlanEntry{Cue: "Saturday fast", Rb: []string{"RB 39.11"}},
+		},
+	}
+
+	testCases := []struct {
+		date        string
+		expectedCue string
+		description string
+	}{
+		{date: "2025-04-04", expectedCue: "Late Lenten Friday", description: "First matching rule wins"},
+		{date: "2025-03-22", expectedCue: "Saturday fast", description: "Rule matches the tags of the season's entry"},
+		{date: "2025-03-21", expectedCue: "Friday in Lent", description: "Season applies when no rule matches"},
+		{date: "2025-03-14", expectedCue: "Recollection day", description: "Monthly rule wins over the rules"},
+	}
+
+	ce := calendar.NewCalendarEngine()
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			dayKey, err := ce.GetRomanDay(tc.date, calendar.RomanCalendar)
+			if err != nil {
+				t.Fatalf("Failed to get Roman day for %s: %v", tc.date, err)
+			}
+
+			entry, err := compile.Compile(ce, *dayKey, testPlan)
+			if err != nil {
+				t.Fatalf("Compile failed: %v", err)
+			}
+
+			if entry.Cue != tc.expectedCue {
+				t.Errorf("Expected cue %q for %s, got %q", tc.expectedCue, tc.date, entry.Cue)
+			}
+		})
+	}
+}
+
+// TestCueTemplates verifies that the placeholders of a cue are expanded for each day.
+func TestCueTemplates(t *testing.T) {
+	testPlan := plan.Plan{
+		Version: 1,
+		Work:    "Cue Templates Test Plan",
+		Witness: "test",
+		Defaults: plan.PlanEntry{
+			Cue: "{{.Weekday}} of the {{.SeasonWeek | ordinal}} week of {{.Season}}",
+			Rb:  []string{"RB 1"},
+		},
+		Seasons: map[string]plan.SeasonPlan{
+			string(calendar.Lent): {
+				Weekdays: map[string]plan.PlanEntry{
+					"sun": {Cue: "{{.Weekday}}: read {{.RbText}}", Rb: []string{"RB 49.1-3", "RB 4.1"}},
+				},
+				Fallback: &plan.PlanEntry{
+					Cue: `Day {{.DayOfSeason}} of Lent, {{.DaysUntil "easter"}} days to Easter`,
+					Rb:  []string{"RB 49.1"},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		date        string
+		expectedCue string
+	}{
+		{date: "2025-03-05", expectedCue: "Day 1 of Lent, 46 days to Easter"},
+		{date: "2025-03-07", expectedCue: "Day 3 of Lent, 44 days to Easter"},
+		{date: "2025-03-09", expectedCue: "Sunday: read RB 49.1–3; RB 4.1"},
+		{date: "2025-07-18", expectedCue: "Friday of the 6th week of Ordinary Time"},
+	}
+
+	ce := calendar.NewCalendarEngine()
+	for _, tc := range testCases {
+		t.Run(tc.date, func(t *testing.T) {
+			dayKey, err := ce.GetRomanDay(tc.date, calendar.RomanCalendar)
+			if err != nil {
+				t.Fatalf("Failed to get Roman day for %s: %v", tc.date, err)
+			}
+
+			entry, err := compile.Compile(ce, *dayKey, testPlan)
+			if err != nil {
+				t.Fatalf("Compile failed: %v", err)
+			}
+
+			if entry.Cue != tc.expectedCue {
+				t.Errorf("Expected cue %q for %s, got %q", tc.expectedCue, tc.date, entry.Cue)
+			}
+		})
+	}
+}
+
+// TestMultilingualCues verifies that cues are chosen in the plan's language, falling back to the default cue, and
+// that placeholders name the season and weekday in that language.
+func TestMultilingualCues(t *testing.T) {
+	testPlan := plan.Plan{
+		Version: 1,
+		Work:    "Multilingual Test Plan",
+		Witness: "test",
+		Defaults: plan.PlanEntry{
+			Cue:  "{{.Weekday}} of {{.Season}}",
+			Cues: map[string]string{"la": "{{.Weekday}}, {{.Season}}", "de": "Schweigen"},
+			Rb:   []string{"RB 6.1"},
+		},
+	}
+
+	testCases := []struct {
+		lang        string
+		expectedCue string
+		expectedTag calendar.Locale
+	}{
+		{lang: "", expectedCue: "Friday of Lent", expectedTag: calendar.English},
+		{lang: "la", expectedCue: "Feria sexta, Quadragesima", expectedTag: calendar.Latin},
+		{lang: "de-AT", expectedCue: "Schweigen", expectedTag: calendar.German},
+		{lang: "es", expectedCue: "Viernes of Cuaresma", expectedTag: calendar.Spanish},
+	}
+
+	ce := calendar.NewCalendarEngine()
+	dayKey, err := ce.GetRomanDay("2025-03-07", calendar.RomanCalendar)
+	if err != nil {
+		t.Fatalf("Failed to get Roman day: %v", err)
+	}
+	for _, tc := range testCases {
+		t.Run(tc.lang, func(t *testing.T) {
+			testPlan.Lang = tc.lang
+			entry, err := compile.Compile(ce, *dayKey, testPlan)
+			if err != nil {
+				t.Fatalf("Compile failed: %v", err)
+			}
+
+			if entry.Cue != tc.expectedCue {
+				t.Errorf("Expected cue %q for %q, got %q", tc.expectedCue, tc.lang, entry.Cue)
+			}
+			if entry.Lang != tc.expectedTag {
+				t.Errorf("Expected language %q, got %q", tc.expectedTag, entry.Lang)
+			}
+		})
+	}
+}
+
+// TestResolution verifies that compiled entries record which part of the plan resolved them and by which key.
+func TestResolution(t *testing.T) {
+	testPlan := createWeekOverridePlan()
+	testPlan.Dates = map[string]plan.PlanEntry{
+		"2025-03-08": {Cue: "Retreat day", Rb: []string{"RB 49.7"}},
+		"03-19":      {Cue: "Saint Joseph", Rb: []string{"RB 49.8"}},
+	}
+	testPlan.Movable = map[string]plan.PlanEntry{"ash-wednesday": {Cue: "Ash Wednesday", Rb: []string{"RB 49.10"}}}
+	testPlan.Monthly = map[string]plan.PlanEntry{"first-sun": {Cue: "Chapter", Rb: []string{"RB 3.1"}}}
+	testPlan.Rules = []plan.Rule{
+		{When: `date == "04-01"`, PlanEntry: plan.PlanEntry{Cue: "Rule", Rb: []string{"RB 4.1"}}},
+	}
+
+	testCases := []struct {
+		date               string
+		expectedResolution plan.Resolution
+		expectedKey        string
+	}{
+		{date: "2025-03-08", expectedResolution: plan.ResolvedDate, expectedKey: "2025-03-08"},
+		{date: "2025-03-05", expectedResolution: plan.ResolvedMovable, expectedKey: "ash-wednesday"},
+		{date: "2025-03-19", expectedResolution: plan.ResolvedAnnual, expectedKey: "03-19"},
+		{date: "2025-03-02", expectedResolution: plan.ResolvedMonthly, expectedKey: "first-sun"},
+		{date: "2025-04-01", expectedResolution: plan.ResolvedRule, expectedKey: `date == "04-01"`},
+		{date: "2025-03-07", expectedResolution: plan.ResolvedWeek, expectedKey: "1/fri"},
+		{date: "2025-03-10", expectedResolution: plan.ResolvedWeekFallback, expectedKey: "1-2"},
+		{date: "2025-03-21", expectedResolution: plan.ResolvedWeekday, expectedKey: "fri"},
+		{date: "2025-03-31", expectedResolution: plan.ResolvedFallback, expectedKey: ""},
+		{date: "2025-05-02", expectedResolution: plan.ResolvedDefault, expectedKey: ""},
+	}
+
+	ce := calendar.NewCalendarEngine()
+	for _, tc := range testCases {
+		t.Run(string(tc.expectedResolution), func(t *testing.T) {
+			dayKey, err := ce.GetRomanDay(tc.date, calendar.RomanCalendar)
+			if err != nil {
+				t.Fatalf("Failed to get Roman day for %s: %v", tc.date, err)
+			}
+
+			entry, err := compile.Compile(ce, *dayKey, testPlan)
+			if err != nil {
+				t.Fatalf("Compile failed: %v", err)
+			}
+
+			if entry.Resolution != tc.expectedResolution {
+				t.Errorf("Expected resolution %q for %s, got %q", tc.expectedResolution, tc.date, entry.Resolution)
+			}
+			if entry.ResolvedBy != tc.expectedKey {
+				t.Errorf("Expected key %q for %s, got %q", tc.expectedKey, tc.date, entry.ResolvedBy)
+			}
+		})
+	}
+}
+
+// TestCoverage verifies that the coverage report sums the resolutions of a year by season and lists the seasons
+// the plan does not cover.
+func TestCoverage(t *testing.T) {
+	testPlan := createWeekOverridePlan()
+	testPlan.Dates = map[string]plan.PlanEntry{"12-25": {Cue: "Christmas", Rb: []string{"RB 53.1"}}}
+
+	ce := calendar.NewCalendarEngine()
+	days, err := ce.GenerateRomanCalendar("2025", calendar.RomanCalendar)
+	if err != nil {
+		t.Fatalf("Failed to generate calendar: %v", err)
+	}
+
+	report, err := compile.Coverage(ce, days, testPlan)
+	if err != nil {
+		t.Fatalf("Coverage failed: %v", err)
+	}
+
+	if len(report.Days) != len(days) {
+		t.Errorf("Expected %d days, got %d", len(days), len(report.Days))
+	}
+	for _, season := range report.Seasons {
+		if season.Season == calendar.Lent {
+			if !season.Planned {
+				t.Error("Expected Lent to be planned")
+			}
+			if season.Days != 43 {
+				t.Errorf("Expected 43 days of Lent, got %d", season.Days)
+			}
+			if season.Resolved[plan.ResolvedDefault] != 0 {
+				t.Errorf("Expected no Lent day to fall to the defaults, got %d", season.Resolved[plan.ResolvedDefault])
+			}
+			continue
+		}
+		if season.Planned {
+			t.Errorf("Expected %s not to be planned", season.Season)
+		}
+	}
+	if slices.Contains(report.MissingSeasons, calendar.Lent) {
+		t.Error("Expected Lent not to be missing")
+	}
+	if !slices.Contains(report.MissingSeasons, calendar.Advent) {
+		t.Errorf("Expected Advent to be missing, got %v", report.MissingSeasons)
+	}
+
+	defaults := report.DefaultDays()
+	if len(defaults) != len(days)-43-1 {
+		t.Errorf("Expected %d days to fall to the defaults, got %d", len(days)-43-1, len(defaults))
+	}
+	for _, day := range defaults {
+		if day.Date == "2025-12-25" {
+			t.Error("Expected Christmas to be resolved by its annual date")
+		}
+	}
+}
+
 // TestMatchingPrecedence_DefaultFallback verifies that defaults are used when season is missing.
 func TestMatchingPrecedence_DefaultFallback(t *testing.T) {
 	testPlan := createDefaultFallbackPlan()
@@ -541,7 +1190,7 @@ func TestMatchingPrecedence_DefaultFallback(t *testing.T) {
 				Weekday:    tc.weekday,
 			}
 
-			entry, err := compile.Compile(dayKey, testPlan)
+			entry, err := compile.Compile(calendar.NewCalendarEngine(), dayKey, testPlan)
 			if err != nil {
 				t.Fatalf("Compile failed: %v", err)
 			}
@@ -577,7 +1226,7 @@ func TestOutputStability(t *testing.T) {
 	var firstEntry *plan.FormattedEntry
 	for i := 0; i < 3; i++ {
 		t.Run(fmt.Sprintf("Compilation%d", i+1), func(t *testing.T) {
-			entry, err := compile.Compile(dayKey, testPlan)
+			entry, err := compile.Compile(calendar.NewCalendarEngine(), dayKey, testPlan)
 			if err != nil {
 				t.Fatalf("Compile failed: %v", err)
 			}
@@ -848,3 +1497,39 @@ func createDefaultFallbackPlan() plan.Plan {
 		},
 	}
 }
+
+func createWeekOverridePlan() plan.Plan {
+	return plan.Plan{
+		Version: 1,
+		Work:    "Week Override Test Plan",
+		Witness: "test",
+		Defaults: plan.PlanEntry{
+			Cue: "Default Reading",
+			Rb:  []string{"RB 1"},
+		},
+		Seasons: map[string]plan.SeasonPlan{
+			string(calendar.Lent): {
+				Weekdays: map[string]plan.PlanEntry{
+					"fri": {Cue: "Friday in Lent", Rb: []string{"RB 49.1"}},
+				},
+				Fallback: &plan.PlanEntry{Cue: "Lent", Rb: []string{"RB 49.2"}},
+				Weeks: map[string]plan.WeekPlan{
+					"1": {
+						Weekdays: map[string]plan.PlanEntry{
+							"fri": {Cue: "First Friday of Lent", Rb: []string{"RB 49.3"}},
+						},
+					},
+					"1-2": {
+						Fallback: &plan.PlanEntry{Cue: "Early Lent", Rb: []string{"RB 49.4"}},
+					},
+					"last": {
+						Weekdays: map[string]plan.PlanEntry{
+							"wed": {Cue: "Spy Wednesday", Rb: []string{"RB 49.5"}},
+						},
+						Fallback: &plan.PlanEntry{Cue: "Holy Week", Rb: []string{"RB 49.6"}},
+					},
+				},
+			},
+		},
+	}
+}
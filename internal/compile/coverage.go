@@ -0,0 +1,92 @@
+package compile
+
+import (
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+	"github.com/julianstephens/liturgical-time-index/internal/plan"
+)
+
+// CoverageReport tells how a plan resolves each day of a calendar and sums the resolutions by season.
+type CoverageReport struct {
+	Days    []DayCoverage    `json:"days"`
+	Seasons []SeasonCoverage `json:"seasons"`
+	// MissingSeasons lists the seasons of the calendar the plan has no entries for, whose days all fall to the
+	// plan's defaults unless a date, movable, monthly or rule entry catches them.
+	MissingSeasons []calendar.LiturgicalSeason `json:"missing_seasons"`
+}
+
+// DayCoverage is the part of the plan that resolved a day, and the key within it (a date, weekday key, week key,
+// monthly key or rule expression).
+type DayCoverage struct {
+	Date       string                    `json:"date"`
+	Season     calendar.LiturgicalSeason `json:"season"`
+	SeasonWeek int                       `json:"season_week"`
+	Weekday    calendar.Weekday          `json:"weekday"`
+	Resolution plan.Resolution           `json:"resolution"`
+	ResolvedBy string                    `json:"resolved_by,omitempty"`
+}
+
+// SeasonCoverage counts the days of a season by how the plan resolved them. Planned is false for a season the plan
+// has no entries for.
+type SeasonCoverage struct {
+	Season   calendar.LiturgicalSeason `json:"season"`
+	Planned  bool                      `json:"planned"`
+	Days     int                       `json:"days"`
+	Resolved map[plan.Resolution]int   `json:"resolved"`
+}
+
+// Coverage compiles every day with the plan and reports which part of the plan resolved it. Seasons are listed in
+// the order they first occur in the days.
+func Coverage(ce *calendar.CalendarEngine, days []calendar.DayKey, p plan.Plan) (*CoverageReport, error) {
+	report := &CoverageReport{
+		Days:           make([]DayCoverage, 0, len(days)),
+		Seasons:        []SeasonCoverage{},
+		MissingSeasons: []calendar.LiturgicalSeason{},
+	}
+	seasons := map[calendar.LiturgicalSeason]int{}
+	for _, day := range days {
+		entry, err := Compile(ce, day, p)
+		if err != nil {
+			return nil, err
+		}
+		report.Days = append(report.Days, DayCoverage{
+			Date:       day.Date,
+			Season:     day.Season,
+			SeasonWeek: day.SeasonWeek,
+			Weekday:    day.Weekday,
+			Resolution: entry.Resolution,
+			ResolvedBy: entry.ResolvedBy,
+		})
+
+		i, ok := seasons[day.Season]
+		if !ok {
+			_, planned, err := p.SeasonPlan(string(day.Season))
+			if err != nil {
+				return nil, err
+			}
+			i = len(report.Seasons)
+			seasons[day.Season] = i
+			report.Seasons = append(report.Seasons, SeasonCoverage{
+				Season:   day.Season,
+				Planned:  planned,
+				Resolved: map[plan.Resolution]int{},
+			})
+			if !planned {
+				report.MissingSeasons = append(report.MissingSeasons, day.Season)
+			}
+		}
+		report.Seasons[i].Days++
+		report.Seasons[i].Resolved[entry.Resolution]++
+	}
+	return report, nil
+}
+
+// DefaultDays returns the days of the report that fell to the plan's defaults.
+func (r *CoverageReport) DefaultDays() []DayCoverage {
+	defaults := []DayCoverage{}
+	for _, day := range r.Days {
+		if day.Resolution == plan.ResolvedDefault {
+			defaults = append(defaults, day)
+		}
+	}
+	return defaults
+}
@@ -0,0 +1,84 @@
+package output
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"github.com/julianstephens/go-utils/generic"
+	"gopkg.in/yaml.v3"
+
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+)
+
+type CalendarFormat string
+
+const (
+	FormatJSON CalendarFormat = "json"
+	FormatCSV  CalendarFormat = "csv"
+	FormatYAML CalendarFormat = "yaml"
+)
+
+// calendarColumns are the CSV columns of an exported calendar, named after the DayKey json tags.
+var calendarColumns = []string{
+	"date", "julian_date", "native_date", "tradition", "season", "season_week", "weekday", "celebration", "colour",
+}
+
+// Calendar writes the computed calendar days to w in the given format, without any plan entries.
+func Calendar(days []calendar.DayKey, format CalendarFormat, w io.Writer) error {
+	var err error
+	switch format {
+	case FormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(days)
+	case FormatYAML:
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		err = encoder.Encode(days)
+		if err == nil {
+			err = encoder.Close()
+		}
+	case FormatCSV:
+		err = calendarCSV(days, w)
+	default:
+		return &OutputError{
+			Message: generic.Ptr("unsupported calendar format: " + string(format)),
+			Err:     ErrSerializationFailed,
+		}
+	}
+
+	if err != nil {
+		return &OutputError{
+			Message: generic.Ptr("failed to write calendar as " + string(format)),
+			Err:     ErrSerializationFailed,
+			Cause:   err,
+		}
+	}
+	return nil
+}
+
+func calendarCSV(days []calendar.DayKey, w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(calendarColumns); err != nil {
+		return err
+	}
+	for _, day := range days {
+		if err := writer.Write([]string{
+			day.Date,
+			day.JulianDate,
+			day.NativeDate,
+			string(day.Tradition),
+			string(day.Season),
+			strconv.Itoa(day.SeasonWeek),
+			string(day.Weekday),
+			day.Celebration,
+			string(day.Colour),
+		}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
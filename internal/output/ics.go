@@ -10,10 +10,15 @@ import (
 	"github.com/julianstephens/go-utils/generic"
 	"github.com/julianstephens/go-utils/helpers"
 
+	"github.com/julianstephens/liturgical-time-index/internal"
 	"github.com/julianstephens/liturgical-time-index/internal/plan"
 )
 
-// ICS takes a slice of FormattedEntry and an output path, and writes the entries to an ICalendar file.
+// icsLocalTimeFormat is the layout of an ICalendar date-time without a time zone.
+const icsLocalTimeFormat = "20060102T150405"
+
+// ICS takes a slice of FormattedEntry and an output path, and writes the entries to an ICalendar file. Each slot of
+// an entry becomes an event of its own, at its time of day.
 func ICS(entries []plan.FormattedEntry, outputPath string) error {
 	cal := ics.NewCalendar()
 	cal.SetMethod(ics.MethodPublish)
@@ -21,13 +26,44 @@ func ICS(entries []plan.FormattedEntry, outputPath string) error {
 
 	for _, entry := range entries {
 		formattedDate := strings.ReplaceAll(entry.Key.Date, "-", "")
-		event := cal.AddEvent(fmt.Sprintf("%s-%d-%s", entry.Key.Season, entry.Key.SeasonWeek, formattedDate))
-		event.SetSummary(entry.Cue)
-		event.SetDescription(fmt.Sprintf("%s\n\nRb references:\n%s", entry.Cue, formatRbRefs(entry.Rb)))
+		uid := fmt.Sprintf("%s-%d-%s", entry.Key.Season, entry.Key.SeasonWeek, formattedDate)
+		summary := entry.Cue
+		// Entries of stacked plans share their day, so the plan is part of the UID and shown in the summary.
+		if entry.Plan != "" {
+			uid = fmt.Sprintf("%s-%s", uid, entry.Plan)
+			summary = fmt.Sprintf("[%s] %s", entry.Plan, entry.Cue)
+		}
+
+		// An entry divided into slots gets an event for each slot, and one for the day only if it has its own cue.
+		if len(entry.Slots) == 0 || entry.Cue != "" {
+			event := cal.AddEvent(uid)
+			event.SetSummary(summary)
+			event.SetDescription(icsDescription(entry, entry.Cue, entry.Rb))
+			event.SetDtStampTime(now)
+			event.SetProperty(ics.ComponentPropertyDtStart, formattedDate)
+			event.SetProperty(ics.ComponentPropertyDtEnd, formattedDate)
+		}
+
+		for _, slot := range entry.Slots {
+			event := cal.AddEvent(fmt.Sprintf("%s-%s", uid, slot.Name))
+			slotSummary := fmt.Sprintf("%s: %s", slot.Name, slot.Cue)
+			if entry.Plan != "" {
+				slotSummary = fmt.Sprintf("[%s] %s", entry.Plan, slotSummary)
+			}
+			event.SetSummary(slotSummary)
+			event.SetDescription(icsDescription(entry, slot.Cue, slot.Rb))
+			event.SetDtStampTime(now)
 
-		event.SetDtStampTime(now)
-		event.SetProperty(ics.ComponentPropertyDtStart, formattedDate)
-		event.SetProperty(ics.ComponentPropertyDtEnd, formattedDate)
+			start, err := time.Parse(internal.DateFormat+" 15:04", entry.Key.Date+" "+slot.Time)
+			if slot.Time == "" || err != nil {
+				event.SetProperty(ics.ComponentPropertyDtStart, formattedDate)
+				event.SetProperty(ics.ComponentPropertyDtEnd, formattedDate)
+				continue
+			}
+			// Slot times are local times of day, so they are written without a time zone.
+			event.SetProperty(ics.ComponentPropertyDtStart, start.Format(icsLocalTimeFormat))
+			event.SetProperty(ics.ComponentPropertyDtEnd, start.Add(slot.Duration).Format(icsLocalTimeFormat))
+		}
 	}
 
 	serialized := cal.Serialize(ics.WithNewLineWindows)
@@ -42,6 +78,18 @@ func ICS(entries []plan.FormattedEntry, outputPath string) error {
 	return nil
 }
 
+// icsDescription describes a cue of the entry with its RB references, celebration and Julian date.
+func icsDescription(entry plan.FormattedEntry, cue string, rbRefs []rbref.RbRef) string {
+	description := fmt.Sprintf("%s\n\nRb references:\n%s", cue, formatRbRefs(rbRefs))
+	if entry.Key.Celebration != "" {
+		description = fmt.Sprintf("%s\n\n%s", entry.Key.Celebration, description)
+	}
+	if entry.Key.JulianDate != "" {
+		description = fmt.Sprintf("Julian date: %s\n\n%s", entry.Key.JulianDate, description)
+	}
+	return description
+}
+
 func formatRbRefs(rbRefs []rbref.RbRef) string {
 	var formatted string
 
@@ -0,0 +1,31 @@
+package output_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/julianstephens/liturgical-time-index/internal/output"
+)
+
+func TestICSRomanCelebrations(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "plan.ics")
+	if err := output.ICS(romanEntries(t), path); err != nil {
+		t.Fatalf("ICS failed: %v", err)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read ICS file: %v", err)
+	}
+
+	// Long descriptions are folded across lines, so only their start is compared.
+	for _, description := range []string{
+		`DESCRIPTION:Cue for 2025-07-08\n\nRb references:`,
+		`DESCRIPTION:The Nativity of the Lord\n\nCue for 2025-12-25`,
+	} {
+		if !strings.Contains(string(content), description) {
+			t.Errorf("Expected the ICS file to contain %q, got:\n%s", description, content)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package output
 import (
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -14,6 +15,7 @@ import (
 )
 
 // Markdown takes a slice of FormattedEntry and an output path, and writes the entries to a Markdown file in a tabular format.
+// The slots of an entry follow it as sub-rows.
 func Markdown(entries []plan.FormattedEntry, outputPath string) (retErr error) {
 	f, err := os.OpenFile(filepath.Clean(outputPath), os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0600)
 	if err != nil {
@@ -33,18 +35,55 @@ func Markdown(entries []plan.FormattedEntry, outputPath string) (retErr error) {
 		}
 	}()
 
+	// Traditions with their own civil calendar get an extra column for the native date.
+	hasNativeDates := generic.Any(entries, func(entry plan.FormattedEntry) bool { return entry.Key.NativeDate != "" })
+	hasCelebrations := generic.Any(entries, func(entry plan.FormattedEntry) bool { return entry.Key.Celebration != "" })
+	hasPlans := generic.Any(entries, func(entry plan.FormattedEntry) bool { return entry.Plan != "" })
+
+	header := []string{"Date", "Season", "Season Week", "Weekday", "Cue", "RB References"}
+	if hasPlans {
+		header = slices.Insert(header, 4, "Plan")
+	}
+	if hasCelebrations {
+		header = slices.Insert(header, 4, "Celebration")
+	}
+	if hasNativeDates {
+		header = append([]string{"Date", "Native Date"}, header[1:]...)
+	}
+
+	rows := make([][]string, 0, len(entries))
+	for _, entry := range entries {
+		row := []string{
+			formatDate(entry),
+			entry.Key.Season.Localized(entry.Lang),
+			strconv.Itoa(entry.Key.SeasonWeek),
+			entry.Key.Weekday.Localized(entry.Lang),
+			entry.Cue,
+			formatRbRefList(entry.Rb),
+		}
+		if hasPlans {
+			row = slices.Insert(row, 4, entry.Plan)
+		}
+		if hasCelebrations {
+			row = slices.Insert(row, 4, formatCelebration(entry))
+		}
+		if hasNativeDates {
+			row = append([]string{row[0], entry.Key.NativeDate}, row[1:]...)
+		}
+		rows = append(rows, row)
+
+		// Each slot gets a sub-row under its day, with only the cue and references filled in.
+		for _, slot := range entry.Slots {
+			subRow := make([]string, len(header))
+			subRow[len(subRow)-2] = formatSlotCue(slot)
+			subRow[len(subRow)-1] = formatRbRefList(slot.Rb)
+			rows = append(rows, subRow)
+		}
+	}
+
 	if err := md.NewMarkdown(f).Table(md.TableSet{
-		Header: []string{"Date", "Season", "Season Week", "Weekday", "Cue", "RB References"},
-		Rows: generic.Map(entries, func(entry plan.FormattedEntry) []string {
-			return []string{
-				entry.Key.Date,
-				entry.Key.Season.String(),
-				strconv.Itoa(entry.Key.SeasonWeek),
-				entry.Key.Weekday.String(),
-				entry.Cue,
-				strings.Join(generic.Map(entry.Rb, func(ref rbref.RbRef) string { return ref.String() }), "; "),
-			}
-		}),
+		Header: header,
+		Rows:   rows,
 	}).Build(); err != nil {
 		return &OutputError{
 			Message: generic.Ptr("failed to write Markdown file"),
@@ -55,3 +94,29 @@ func Markdown(entries []plan.FormattedEntry, outputPath string) (retErr error) {
 
 	return nil
 }
+
+// formatDate renders the entry's date, appending the Julian date for days before the Gregorian reform.
+func formatDate(entry plan.FormattedEntry) string {
+	if entry.Key.JulianDate == "" {
+		return entry.Key.Date
+	}
+	return entry.Key.Date + " (Julian " + entry.Key.JulianDate + ")"
+}
+
+// formatRbRefList renders RB references as a single cell.
+func formatRbRefList(refs []rbref.RbRef) string {
+	return strings.Join(generic.Map(refs, func(ref rbref.RbRef) string { return ref.String() }), "; ")
+}
+
+// formatSlotCue renders a slot's cue with its name and, for a timed slot, its time and length.
+func formatSlotCue(slot plan.FormattedSlot) string {
+	return slot.Label() + ": " + slot.Cue
+}
+
+// formatCelebration renders the celebration kept on the entry's day together with its liturgical colour.
+func formatCelebration(entry plan.FormattedEntry) string {
+	if entry.Key.Celebration == "" || entry.Key.Colour == "" {
+		return entry.Key.Celebration
+	}
+	return entry.Key.Celebration + " (" + string(entry.Key.Colour) + ")"
+}
@@ -0,0 +1,65 @@
+package output_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/julianstephens/go-utils/generic"
+
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+	"github.com/julianstephens/liturgical-time-index/internal/output"
+	"github.com/julianstephens/liturgical-time-index/internal/plan"
+)
+
+// romanEntries returns entries for a Roman weekday without a celebration and for Christmas Day.
+func romanEntries(t *testing.T) []plan.FormattedEntry {
+	t.Helper()
+	ce := calendar.NewCalendarEngine()
+
+	entries := []plan.FormattedEntry{}
+	for _, date := range []string{"2025-07-08", "2025-12-25"} {
+		day, err := ce.GetRomanDay(date, calendar.RomanCalendar)
+		if err != nil {
+			t.Fatalf("GetRomanDay failed: %v", err)
+		}
+		entries = append(entries, plan.FormattedEntry{Key: *day, Cue: "Cue for " + date})
+	}
+	return entries
+}
+
+// markdownRows splits a Markdown table into its rows of trimmed cells, including the header and separator rows.
+func markdownRows(content string) [][]string {
+	rows := [][]string{}
+	for _, line := range strings.Split(strings.TrimSpace(content), "\n") {
+		rows = append(rows, generic.Map(strings.Split(strings.Trim(line, "|"), "|"), strings.TrimSpace))
+	}
+	return rows
+}
+
+func TestMarkdownRomanCelebrations(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "plan.md")
+	if err := output.Markdown(romanEntries(t), path); err != nil {
+		t.Fatalf("Markdown failed: %v", err)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read Markdown file: %v", err)
+	}
+
+	// Roman days carry their celebrations, so the table has a Celebration column before the cue.
+	rows := markdownRows(string(content))
+	if len(rows) != 4 {
+		t.Fatalf("Expected a header, a separator and 2 rows, got %q", rows)
+	}
+	if rows[0][4] != "Celebration" {
+		t.Errorf("Expected a Celebration column after the weekday, got header %q", rows[0])
+	}
+	if rows[2][4] != "" {
+		t.Errorf("Expected no celebration on 2025-07-08, got %q", rows[2][4])
+	}
+	if rows[3][4] != "The Nativity of the Lord (white)" {
+		t.Errorf("Expected the Nativity with its colour on 2025-12-25, got %q", rows[3][4])
+	}
+}
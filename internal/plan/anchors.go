@@ -0,0 +1,71 @@
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/julianstephens/go-utils/generic"
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+)
+
+// AnchorEntry overrides a computed date of the calendar for one year: easter, advent1, epiphany, or the start of
+// a season (e.g. lent). The note explains the override and is reported with it. Source is the plan file the entry
+// was read from, which may be a plan the loaded one extends or includes.
+type AnchorEntry struct {
+	Name   string `yaml:"name"`
+	Date   string `yaml:"date"`
+	Note   string `yaml:"note,omitempty"`
+	Source string `yaml:"-"`
+}
+
+// sameOverride reports whether two entries override the same anchor or season in the same year.
+func (e AnchorEntry) sameOverride(other AnchorEntry) bool {
+	return strings.EqualFold(strings.TrimSpace(e.Name), strings.TrimSpace(other.Name)) &&
+		len(e.Date) >= 4 && len(other.Date) >= 4 && e.Date[:4] == other.Date[:4]
+}
+
+// AnchorOverrides returns the plan's anchor overrides, recording the file each entry was read from (or, for an
+// entry without one, the given source) and the entry's note as their provenance.
+func (p *Plan) AnchorOverrides(source string) ([]calendar.AnchorOverride, error) {
+	overrides := make([]calendar.AnchorOverride, 0, len(p.Anchors))
+	for _, entry := range p.Anchors {
+		provenance := source
+		if entry.Source != "" {
+			provenance = entry.Source
+		}
+		if entry.Note != "" {
+			provenance = fmt.Sprintf("%s (%s)", provenance, entry.Note)
+		}
+		override, err := calendar.NewAnchorOverride(entry.Name, entry.Date, provenance)
+		if err != nil {
+			return nil, &PlanError{
+				Message: generic.Ptr("invalid anchor override " + entry.Name + "=" + entry.Date),
+				Err:     ErrInvalidPlanEntry,
+				Cause:   err,
+			}
+		}
+		overrides = append(overrides, override)
+	}
+	return overrides, nil
+}
+
+// validateAnchors checks that every anchor override parses and that no anchor is overridden twice in a year.
+func (p *Plan) validateAnchors() error {
+	overrides, err := p.AnchorOverrides("plan")
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	for _, override := range overrides {
+		key := fmt.Sprintf("%s %d", override.Name(), override.Year())
+		if seen[key] {
+			return &PlanError{
+				Message: generic.Ptr("duplicate anchor override for " + key),
+				Err:     ErrInvalidPlanEntry,
+			}
+		}
+		seen[key] = true
+	}
+	return nil
+}
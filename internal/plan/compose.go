@@ -0,0 +1,155 @@
+package plan
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/julianstephens/go-utils/generic"
+)
+
+// loadPlan reads a plan and resolves its extends and include lists. chain holds the absolute paths of the plans
+// that led to this one, so that a plan reached again through its own includes is reported as a cycle.
+func loadPlan(planPath string, chain []string) (*Plan, error) {
+	absPath, err := filepath.Abs(filepath.Clean(planPath))
+	if err != nil {
+		return nil, &PlanError{
+			Err:   ErrPlanFileRead,
+			Cause: err,
+		}
+	}
+	if slices.Contains(chain, absPath) {
+		return nil, &PlanError{
+			Message: generic.Ptr("plan includes itself: " + strings.Join(append(chain, absPath), " -> ")),
+			Err:     ErrPlanCycle,
+		}
+	}
+	chain = append(slices.Clone(chain), absPath)
+
+	p, err := readPlan(planPath)
+	if err != nil {
+		return nil, err
+	}
+	for i := range p.Anchors {
+		p.Anchors[i].Source = planPath
+	}
+	if p.Extends == "" && len(p.Include) == 0 {
+		return p, nil
+	}
+
+	// The base plan comes first, then the includes in order, then the plan itself, each layered over the last.
+	layers := slices.Clone(p.Include)
+	if p.Extends != "" {
+		layers = append([]string{p.Extends}, layers...)
+	}
+	merged := &Plan{}
+	for _, layer := range layers {
+		if !filepath.IsAbs(layer) {
+			layer = filepath.Join(filepath.Dir(planPath), layer)
+		}
+		layerPlan, err := loadPlan(layer, chain)
+		if err != nil {
+			return nil, err
+		}
+		merged.merge(layerPlan)
+	}
+	merged.merge(p)
+	merged.Extends, merged.Include = "", nil
+	return merged, nil
+}
+
+// merge layers another plan over this one. Scalars and the defaults are replaced when the other plan sets them.
+// Seasons are merged season by season: each weekday entry, week plan and the fallback the other plan sets
+// replaces this plan's, and the rest are kept. Season groups, date, movable and monthly entries are replaced key
+// by key. The other plan's rules are tried before this plan's, and anchor overrides replace those for the same
+// anchor and year.
+func (p *Plan) merge(other *Plan) {
+	if other.Version != 0 {
+		p.Version = other.Version
+	}
+	if other.Work != "" {
+		p.Work = other.Work
+	}
+	if other.Witness != "" {
+		p.Witness = other.Witness
+	}
+	if other.Lang != "" {
+		p.Lang = other.Lang
+	}
+	if !other.Defaults.isEmpty() {
+		p.Defaults = other.Defaults
+	}
+
+	for name, season := range other.Seasons {
+		if p.Seasons == nil {
+			p.Seasons = make(map[string]SeasonPlan)
+		}
+		p.Seasons[name] = p.Seasons[name].layer(season)
+	}
+	for name, seasons := range other.Groups {
+		if p.Groups == nil {
+			p.Groups = make(map[string][]string)
+		}
+		p.Groups[name] = seasons
+	}
+
+	p.Dates = mergeEntries(p.Dates, other.Dates)
+	p.Movable = mergeEntries(p.Movable, other.Movable)
+	p.Monthly = mergeEntries(p.Monthly, other.Monthly)
+	if len(other.Rules) > 0 {
+		p.Rules = append(slices.Clone(other.Rules), p.Rules...)
+	}
+
+	for _, anchor := range other.Anchors {
+		p.Anchors = slices.DeleteFunc(p.Anchors, func(a AnchorEntry) bool { return a.sameOverride(anchor) })
+		p.Anchors = append(p.Anchors, anchor)
+	}
+}
+
+// layer returns the season plan with another layered over it: each weekday entry, week plan weekday and fallback
+// the other sets replaces this one's, and the rest are kept.
+func (s SeasonPlan) layer(other SeasonPlan) SeasonPlan {
+	s.Weekdays = mergeWeekdays(s.Weekdays, other.Weekdays)
+	if other.Fallback != nil {
+		s.Fallback = other.Fallback
+	}
+	if other.Inherits != "" {
+		s.Inherits = other.Inherits
+	}
+	if len(other.Weeks) > 0 {
+		weeks := make(map[string]WeekPlan, len(s.Weeks)+len(other.Weeks))
+		for key, week := range s.Weeks {
+			weeks[key] = week
+		}
+		for key, week := range other.Weeks {
+			baseWeek := weeks[key]
+			baseWeek.Weekdays = mergeWeekdays(baseWeek.Weekdays, week.Weekdays)
+			if week.Fallback != nil {
+				baseWeek.Fallback = week.Fallback
+			}
+			weeks[key] = baseWeek
+		}
+		s.Weeks = weeks
+	}
+	return s
+}
+
+// mergeEntries returns the base entries with those of the overlay added or replaced.
+func mergeEntries(base, overlay map[string]PlanEntry) map[string]PlanEntry {
+	if len(overlay) == 0 {
+		return base
+	}
+	merged := make(map[string]PlanEntry, len(base)+len(overlay))
+	for key, entry := range base {
+		merged[key] = entry
+	}
+	for key, entry := range overlay {
+		merged[key] = entry
+	}
+	return merged
+}
+
+// isEmpty reports whether the entry sets nothing, as when a layered plan leaves out its defaults.
+func (e *PlanEntry) isEmpty() bool {
+	return e.Cue == "" && len(e.Rb) == 0 && e.Tags == nil && !e.IsRotating() && len(e.Slots) == 0
+}
@@ -0,0 +1,168 @@
+package plan
+
+import (
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/julianstephens/canonref/rbref"
+	"github.com/julianstephens/go-utils/generic"
+	"github.com/julianstephens/liturgical-time-index/internal"
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+)
+
+// cueFuncs are the functions a cue template can call besides the methods of CueData.
+var cueFuncs = template.FuncMap{
+	"ordinal": ordinal,
+}
+
+// CueData is what a cue template is expanded with, e.g. "Day {{.DayOfSeason}} of {{.Season}}" or
+// "{{.SeasonWeek | ordinal}} week, {{.DaysUntil \"easter\"}} days to Easter". Season and Weekday are the names
+// shown to readers ("Lent", "Friday"), and RbText the entry's RB references ("RB 49.1-3; RB 4.1").
+type CueData struct {
+	Date        string
+	Season      string
+	SeasonWeek  int
+	Weekday     string
+	Celebration string
+	Colour      string
+	RbText      string
+
+	ce  *calendar.CalendarEngine
+	key calendar.DayKey
+}
+
+// NewCueData returns the data for expanding the cue of an entry with the given RB references on the day, naming the
+// season and weekday in the locale. The engine answers DaysUntil and DayOfSeason.
+func NewCueData(
+	ce *calendar.CalendarEngine,
+	key calendar.DayKey,
+	rb []rbref.RbRef,
+	locale calendar.Locale,
+) CueData {
+	return CueData{
+		Date:        key.Date,
+		Season:      key.Season.Localized(locale),
+		SeasonWeek:  key.SeasonWeek,
+		Weekday:     key.Weekday.Localized(locale),
+		Celebration: key.Celebration,
+		Colour:      string(key.Colour),
+		RbText:      strings.Join(generic.Map(rb, func(ref rbref.RbRef) string { return ref.String() }), "; "),
+		ce:          ce,
+		key:         key,
+	}
+}
+
+// DayOfSeason returns the position of the day in the run of its season, counting the first day as 1.
+func (d CueData) DayOfSeason() (int, error) {
+	if d.ce == nil {
+		return 1, nil
+	}
+	return d.ce.DayOfSeason(d.key)
+}
+
+// DaysUntil returns the number of days from the day to the next occurrence of an anchor with an optional offset
+// ("easter", "pentecost-9"), 0 on the day itself.
+func (d CueData) DaysUntil(spec string) (int, error) {
+	anchor, err := calendar.ParseAnchorOffset(spec)
+	if err != nil {
+		return 0, err
+	}
+	if d.ce == nil {
+		return 0, nil
+	}
+
+	date, err := time.Parse(internal.DateFormat, d.key.Date)
+	if err != nil {
+		return 0, err
+	}
+	for year := date.Year(); year <= date.Year()+1; year++ {
+		target, err := d.ce.AnchorOffsetDate(anchor, year, d.key.Tradition)
+		if err != nil {
+			return 0, err
+		}
+		if !target.Before(date) {
+			return int(target.Sub(date).Hours() / 24), nil
+		}
+	}
+	return 0, &PlanError{
+		Message: generic.Ptr("no date for " + spec + " after " + d.key.Date),
+		Err:     ErrInvalidPlanEntry,
+	}
+}
+
+// ExpandCues chooses the entry's cue and its slots' cues in a language (a BCP 47 tag, or empty for the default
+// cues) and expands their placeholders for the day, each with its own RB references.
+func (e *FormattedEntry) ExpandCues(ce *calendar.CalendarEngine, key calendar.DayKey, lang string) error {
+	locale := calendar.English
+	if lang != "" {
+		parsed, err := calendar.ParseLocale(lang)
+		if err != nil {
+			return err
+		}
+		locale = parsed
+	}
+	e.Lang = locale
+
+	cue, err := ExpandCue(localizedCue(e.Cue, e.Cues, lang), NewCueData(ce, key, e.Rb, locale))
+	if err != nil {
+		return err
+	}
+	e.Cue = cue
+	for i, slot := range e.Slots {
+		cue, err := ExpandCue(localizedCue(slot.Cue, slot.Cues, lang), NewCueData(ce, key, slot.Rb, locale))
+		if err != nil {
+			return err
+		}
+		e.Slots[i].Cue = cue
+	}
+	return nil
+}
+
+// ExpandCue expands the template placeholders of a cue for a day. A cue without placeholders is returned as it is.
+func ExpandCue(cue string, data CueData) (string, error) {
+	if !strings.Contains(cue, "{{") {
+		return cue, nil
+	}
+	tmpl, err := template.New("cue").Funcs(cueFuncs).Option("missingkey=error").Parse(cue)
+	if err != nil {
+		return "", cueError(cue, err)
+	}
+	var expanded strings.Builder
+	if err := tmpl.Execute(&expanded, data); err != nil {
+		return "", cueError(cue, err)
+	}
+	return expanded.String(), nil
+}
+
+// validateCue checks that a cue's placeholders parse and name only the variables and functions a cue can use, by
+// expanding it for a sample day.
+func validateCue(cue string) error {
+	_, err := ExpandCue(cue, CueData{SeasonWeek: 1})
+	return err
+}
+
+// cueError reports a cue template that cannot be expanded.
+func cueError(cue string, err error) error {
+	return &PlanError{
+		Message: generic.Ptr("invalid cue template " + strconv.Quote(cue)),
+		Err:     ErrInvalidPlanEntry,
+		Cause:   err,
+	}
+}
+
+// ordinal renders a number as an English ordinal: 1st, 2nd, 3rd, 4th, 11th, 22nd.
+func ordinal(n int) string {
+	suffix := "th"
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+	case n%10 == 1:
+		suffix = "st"
+	case n%10 == 2:
+		suffix = "nd"
+	case n%10 == 3:
+		suffix = "rd"
+	}
+	return strconv.Itoa(n) + suffix
+}
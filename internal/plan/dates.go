@@ -0,0 +1,57 @@
+package plan
+
+import (
+	"time"
+
+	"github.com/julianstephens/go-utils/generic"
+	"github.com/julianstephens/liturgical-time-index/internal"
+)
+
+// annualDateFormat is the layout of the keys of Plan.Dates that recur every year.
+const annualDateFormat = "01-02"
+
+// DateEntry returns the plan entry for a date (YYYY-MM-DD) with its key: the entry for that exact date if there is
+// one, otherwise the entry for the same month and day in every year.
+func (p *Plan) DateEntry(date string) (MatchedEntry, bool) {
+	if entry, ok := p.Dates[date]; ok {
+		return MatchedEntry{PlanEntry: entry, Key: date}, true
+	}
+	parsed, err := time.Parse(internal.DateFormat, date)
+	if err != nil {
+		return MatchedEntry{}, false
+	}
+	annual := parsed.Format(annualDateFormat)
+	entry, ok := p.Dates[annual]
+	return MatchedEntry{PlanEntry: entry, Key: annual}, ok
+}
+
+// validateDates checks that every key of the date entries is a YYYY-MM-DD or MM-DD date and that the entries are
+// valid.
+func (p *Plan) validateDates() error {
+	for date, entry := range p.Dates {
+		if !isPlanDate(date) {
+			return &PlanError{
+				Message: generic.Ptr("invalid date " + date + ": expected YYYY-MM-DD or MM-DD"),
+				Err:     ErrInvalidPlanEntry,
+			}
+		}
+		if _, err := entry.Validate(); err != nil {
+			return &PlanError{
+				Message: generic.Ptr("invalid plan entry for date " + date),
+				Err:     ErrInvalidPlanEntry,
+				Cause:   err,
+			}
+		}
+	}
+	return nil
+}
+
+// isPlanDate reports whether a key of the date entries is a valid date. Annual dates are checked against a leap
+// year so that 02-29 is accepted.
+func isPlanDate(date string) bool {
+	if _, err := time.Parse(internal.DateFormat, date); err == nil {
+		return true
+	}
+	_, err := time.Parse(internal.DateFormat, "2000-"+date)
+	return err == nil && len(date) == len(annualDateFormat)
+}
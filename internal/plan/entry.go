@@ -6,20 +6,44 @@ import (
 	"github.com/julianstephens/liturgical-time-index/internal/calendar"
 )
 
+// PlanEntry is the practice of a day. Cues holds the translations of a cue given as a map of language to string,
+// with Cue its default text.
 type PlanEntry struct {
-	Cue  string    `yaml:"cue"`
-	Rb   []string  `yaml:"rb"`
-	Tags *[]string `yaml:"tags,omitempty"`
+	Cue      string               `yaml:"cue"`
+	Cues     map[string]string    `yaml:"-"`
+	Rb       []string             `yaml:"rb"`
+	Tags     *[]string            `yaml:"tags,omitempty"`
+	Rotation Rotation             `yaml:"rotation,omitempty"`
+	Entries  []PlanEntry          `yaml:"entries,omitempty"`
+	Slots    map[string]SlotEntry `yaml:"slots,omitempty"`
 }
 
+// FormattedEntry is a plan entry compiled for a day. Lang is the language its cues were chosen in, for the outputs
+// to name the season and weekday in. Resolution and ResolvedBy tell which part of the plan, and which key of it, gave
+// the day its entry.
 type FormattedEntry struct {
-	Key  calendar.DayKey `yaml:"key"`
-	Cue  string          `yaml:"cue"`
-	Rb   []rbref.RbRef   `yaml:"rb"`
-	Tags *[]string       `yaml:"tags,omitempty"`
+	Key        calendar.DayKey   `yaml:"key"`
+	Plan       string            `yaml:"plan,omitempty"`
+	Lang       calendar.Locale   `yaml:"lang,omitempty"`
+	Cue        string            `yaml:"cue"`
+	Cues       map[string]string `yaml:"-"`
+	Rb         []rbref.RbRef     `yaml:"rb"`
+	Tags       *[]string         `yaml:"tags,omitempty"`
+	Slots      []FormattedSlot   `yaml:"slots,omitempty"`
+	Resolution Resolution        `yaml:"resolution,omitempty"`
+	ResolvedBy string            `yaml:"resolved_by,omitempty"`
 }
 
 func (e *PlanEntry) Validate() (*FormattedEntry, error) {
+	if err := e.validateRotation(); err != nil {
+		return nil, err
+	}
+	if err := validateCue(e.Cue); err != nil {
+		return nil, err
+	}
+	if err := validateCues(e.Cues); err != nil {
+		return nil, err
+	}
 	refs := make([]rbref.RbRef, len(e.Rb))
 	for i, rbRef := range e.Rb {
 		ref, err := rbref.NewRbRef(rbRef)
@@ -28,9 +52,15 @@ func (e *PlanEntry) Validate() (*FormattedEntry, error) {
 		}
 		refs[i] = *ref
 	}
+	slots, err := e.formatSlots()
+	if err != nil {
+		return nil, err
+	}
 	return &FormattedEntry{
-		Cue:  e.Cue,
-		Rb:   refs,
-		Tags: e.Tags,
+		Cue:   e.Cue,
+		Cues:  e.Cues,
+		Rb:    refs,
+		Tags:  e.Tags,
+		Slots: slots,
 	}, nil
 }
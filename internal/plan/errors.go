@@ -12,6 +12,7 @@ var (
 	ErrPlanFileRead       = errors.New("failed to read plan file")
 	ErrParsePlanFailed    = errors.New("failed to parse plan file")
 	ErrInvalidPlanEntry   = errors.New("invalid plan entry")
+	ErrPlanCycle          = errors.New("plan include cycle")
 )
 
 type PlanError struct {
@@ -0,0 +1,122 @@
+package plan
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/julianstephens/go-utils/generic"
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+)
+
+// SeasonPlan returns the plan in effect for a season: the plan of the group the season belongs to, then the plan
+// of the season it inherits from, then its own plan, each layered over the last so that the season's own weekday
+// entries and fallback win. The boolean is false if none of them is planned.
+func (p *Plan) SeasonPlan(season string) (SeasonPlan, bool, error) {
+	return p.seasonPlan(season, nil)
+}
+
+// seasonPlan resolves a season plan; chain holds the seasons whose inherits led to this one.
+func (p *Plan) seasonPlan(season string, chain []string) (SeasonPlan, bool, error) {
+	if slices.Contains(chain, season) {
+		return SeasonPlan{}, false, &PlanError{
+			Message: generic.Ptr("season inherits from itself: " + strings.Join(append(chain, season), " -> ")),
+			Err:     ErrPlanCycle,
+		}
+	}
+	chain = append(slices.Clone(chain), season)
+
+	var effective SeasonPlan
+	found := false
+	if group, ok := p.groupOf(season); ok {
+		if groupPlan, ok := p.Seasons[group]; ok {
+			effective = effective.layer(groupPlan)
+			found = true
+		}
+	}
+
+	own, ok := p.Seasons[season]
+	if ok && own.Inherits != "" {
+		inherited, inheritedFound, err := p.seasonPlan(own.Inherits, chain)
+		if err != nil {
+			return SeasonPlan{}, false, err
+		}
+		if !inheritedFound {
+			return SeasonPlan{}, false, &PlanError{
+				Message: generic.Ptr("season " + season + " inherits from " + own.Inherits + ", which has no plan"),
+				Err:     ErrInvalidPlanEntry,
+			}
+		}
+		effective = effective.layer(inherited)
+	}
+	if ok {
+		effective = effective.layer(own)
+		found = true
+	}
+	effective.Inherits = ""
+	return effective, found, nil
+}
+
+// groupOf returns the group the season belongs to, if any.
+func (p *Plan) groupOf(season string) (string, bool) {
+	for name, members := range p.Groups {
+		for _, member := range members {
+			if parsed, err := calendar.ParseSeason(member); err == nil && string(parsed) == season {
+				return name, true
+			}
+		}
+	}
+	return "", false
+}
+
+// plannedSeasons returns the seasons the plan covers, directly or through a group, in a stable order. Group
+// names are left out: a group's plan is checked as part of its seasons' plans.
+func (p *Plan) plannedSeasons() []string {
+	seasons := []string{}
+	for name := range p.Seasons {
+		if _, isGroup := p.Groups[name]; !isGroup {
+			seasons = append(seasons, name)
+		}
+	}
+	for _, members := range p.Groups {
+		for _, member := range members {
+			if parsed, err := calendar.ParseSeason(member); err == nil && !slices.Contains(seasons, string(parsed)) {
+				seasons = append(seasons, string(parsed))
+			}
+		}
+	}
+	sort.Strings(seasons)
+	return seasons
+}
+
+// validateGroups checks that group names do not shadow a season, that their members are seasons, and that no
+// season belongs to two groups.
+func (p *Plan) validateGroups() error {
+	groupOf := make(map[calendar.LiturgicalSeason]string)
+	for _, name := range generic.Keys(p.Groups) {
+		if _, err := calendar.ParseSeason(name); err == nil {
+			return &PlanError{
+				Message: generic.Ptr("group " + name + " has the name of a season"),
+				Err:     ErrInvalidPlanEntry,
+			}
+		}
+		for _, member := range p.Groups[name] {
+			season, err := calendar.ParseSeason(member)
+			if err != nil {
+				return &PlanError{
+					Message: generic.Ptr("invalid season " + member + " in group " + name),
+					Err:     ErrInvalidPlanEntry,
+					Cause:   err,
+				}
+			}
+			if other, ok := groupOf[season]; ok && other != name {
+				return &PlanError{
+					Message: generic.Ptr("season " + member + " belongs to both groups " + other + " and " + name),
+					Err:     ErrInvalidPlanEntry,
+				}
+			}
+			groupOf[season] = name
+		}
+	}
+	return nil
+}
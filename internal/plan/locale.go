@@ -0,0 +1,123 @@
+package plan
+
+import (
+	"sort"
+
+	"github.com/julianstephens/go-utils/generic"
+	"gopkg.in/yaml.v3"
+
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+)
+
+// UnmarshalYAML reads an entry whose cue is either a string or a map of language to string
+// (`cue: { en: "Silence", la: "Silentium" }`).
+func (e *PlanEntry) UnmarshalYAML(node *yaml.Node) error {
+	type rawEntry PlanEntry
+	node, cues, err := splitCues(node)
+	if err != nil {
+		return err
+	}
+	if err := node.Decode((*rawEntry)(e)); err != nil {
+		return err
+	}
+	e.Cues = cues
+	return nil
+}
+
+// UnmarshalYAML reads a slot whose cue is either a string or a map of language to string. The slot's own fields are
+// read apart from its entry's, which would otherwise be read by the entry's UnmarshalYAML alone.
+func (s *SlotEntry) UnmarshalYAML(node *yaml.Node) error {
+	var timing struct {
+		Time     string `yaml:"time"`
+		Duration string `yaml:"duration"`
+	}
+	if err := node.Decode(&timing); err != nil {
+		return err
+	}
+	s.Time, s.Duration = timing.Time, timing.Duration
+	return s.PlanEntry.UnmarshalYAML(node)
+}
+
+// UnmarshalYAML reads a rule whose cue is either a string or a map of language to string.
+func (r *Rule) UnmarshalYAML(node *yaml.Node) error {
+	var condition struct {
+		When string `yaml:"when"`
+	}
+	if err := node.Decode(&condition); err != nil {
+		return err
+	}
+	r.When = condition.When
+	return r.PlanEntry.UnmarshalYAML(node)
+}
+
+// splitCues takes a translated cue out of an entry's node. It returns a copy of the node with the cue replaced by
+// its default text, and the translations; a cue given as a string is left as it is.
+func splitCues(node *yaml.Node) (*yaml.Node, map[string]string, error) {
+	if node.Kind != yaml.MappingNode {
+		return node, nil, nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		if key.Value != "cue" || value.Kind != yaml.MappingNode {
+			continue
+		}
+		var cues map[string]string
+		if err := value.Decode(&cues); err != nil {
+			return nil, nil, err
+		}
+		copied := *node
+		copied.Content = append([]*yaml.Node(nil), node.Content...)
+		copied.Content[i+1] = &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: defaultCue(cues)}
+		return &copied, cues, nil
+	}
+	return node, nil, nil
+}
+
+// defaultCue returns the cue used when no language is chosen or the chosen one has no translation: the English
+// cue, or else the first by language tag.
+func defaultCue(cues map[string]string) string {
+	if cue, ok := cues[string(calendar.English)]; ok {
+		return cue
+	}
+	languages := generic.Keys(cues)
+	sort.Strings(languages)
+	if len(languages) == 0 {
+		return ""
+	}
+	return cues[languages[0]]
+}
+
+// localizedCue returns the translation of a cue for a language tag, trying the tag itself and then its base
+// language, or the cue if there is none.
+func localizedCue(cue string, cues map[string]string, lang string) string {
+	if lang == "" || len(cues) == 0 {
+		return cue
+	}
+	if translated, ok := cues[lang]; ok {
+		return translated
+	}
+	if locale, err := calendar.ParseLocale(lang); err == nil {
+		if translated, ok := cues[string(locale)]; ok {
+			return translated
+		}
+	}
+	return cue
+}
+
+// validateCues checks that the languages of a translated cue are valid language tags and that each translation's
+// placeholders can be expanded.
+func validateCues(cues map[string]string) error {
+	for lang, cue := range cues {
+		if _, err := calendar.ParseLocale(lang); err != nil {
+			return &PlanError{
+				Message: generic.Ptr("invalid cue language " + lang),
+				Err:     ErrInvalidPlanEntry,
+				Cause:   err,
+			}
+		}
+		if err := validateCue(cue); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package plan
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/julianstephens/go-utils/generic"
+	"github.com/julianstephens/liturgical-time-index/internal"
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+)
+
+// monthlyOrdinals are the positions of a weekday in its month a monthly key can name. "last" is the last such
+// weekday, whether it is the fourth or the fifth.
+var monthlyOrdinals = map[string]int{
+	"first":  1,
+	"second": 2,
+	"third":  3,
+	"fourth": 4,
+	"fifth":  5,
+	"last":   -1,
+}
+
+// monthlyRule is a parsed key of Plan.Monthly: a day of the month (day-15) or the nth weekday of the month
+// (first-fri, last-sun).
+type monthlyRule struct {
+	key     string
+	day     int
+	nth     int
+	weekday calendar.Weekday
+}
+
+// parseMonthlyRule parses a key of the monthly entries.
+func parseMonthlyRule(key string) (monthlyRule, error) {
+	invalid := &PlanError{
+		Message: generic.Ptr(
+			"invalid monthly rule " + key + ": expected day-N (1 to 31) or first, second, third, fourth, fifth or " +
+				"last followed by a weekday, such as first-fri or last-sun",
+		),
+		Err: ErrInvalidPlanEntry,
+	}
+
+	prefix, rest, ok := strings.Cut(strings.ToLower(strings.TrimSpace(key)), "-")
+	if !ok {
+		return monthlyRule{}, invalid
+	}
+	if prefix == "day" {
+		day, err := strconv.Atoi(rest)
+		if err != nil || day < 1 || day > 31 {
+			return monthlyRule{}, invalid
+		}
+		return monthlyRule{key: key, day: day}, nil
+	}
+
+	nth, ok := monthlyOrdinals[prefix]
+	if !ok {
+		return monthlyRule{}, invalid
+	}
+	weekday, err := parseWeekdayAbbreviation(rest)
+	if err != nil {
+		return monthlyRule{}, invalid
+	}
+	return monthlyRule{key: key, nth: nth, weekday: weekday}, nil
+}
+
+// matches reports whether the rule falls on the date.
+func (r monthlyRule) matches(date time.Time) bool {
+	if r.day > 0 {
+		return date.Day() == r.day
+	}
+	if !strings.EqualFold(date.Weekday().String()[:3], string(r.weekday)) {
+		return false
+	}
+	if r.nth < 0 {
+		return date.AddDate(0, 0, 7).Month() != date.Month()
+	}
+	return (date.Day()-1)/7+1 == r.nth
+}
+
+// specificity orders rules for matching: a day of the month first, then the nth weekday counted from the start of
+// the month, then the last weekday of the month.
+func (r monthlyRule) specificity() int {
+	switch {
+	case r.day > 0:
+		return 1
+	case r.nth > 0:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// monthlyRules returns the parsed keys of the monthly entries, most specific first and then by key so that the
+// order is stable. Keys that do not parse are skipped; Validate reports them.
+func (p *Plan) monthlyRules() []monthlyRule {
+	rules := []monthlyRule{}
+	for key := range p.Monthly {
+		if rule, err := parseMonthlyRule(key); err == nil {
+			rules = append(rules, rule)
+		}
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		if rules[i].specificity() != rules[j].specificity() {
+			return rules[i].specificity() < rules[j].specificity()
+		}
+		return rules[i].key < rules[j].key
+	})
+	return rules
+}
+
+// MonthlyEntry returns the plan entry for a date (YYYY-MM-DD) from the rules that follow the civil month, with the
+// key of the rule. When several rules fall on the date, a day of the month wins over the nth weekday, and the nth
+// weekday over the last.
+func (p *Plan) MonthlyEntry(date string) (MatchedEntry, bool) {
+	if len(p.Monthly) == 0 {
+		return MatchedEntry{}, false
+	}
+	parsed, err := time.Parse(internal.DateFormat, date)
+	if err != nil {
+		return MatchedEntry{}, false
+	}
+	for _, rule := range p.monthlyRules() {
+		if rule.matches(parsed) {
+			return MatchedEntry{PlanEntry: p.Monthly[rule.key], Key: rule.key}, true
+		}
+	}
+	return MatchedEntry{}, false
+}
+
+// validateMonthly checks that every key of the monthly entries is a valid rule, that no two keys name the same
+// rule, and that the entries are valid.
+func (p *Plan) validateMonthly() error {
+	seen := map[monthlyRule]string{}
+	for key, entry := range p.Monthly {
+		rule, err := parseMonthlyRule(key)
+		if err != nil {
+			return err
+		}
+		normalized := rule
+		normalized.key = ""
+		if other, ok := seen[normalized]; ok {
+			return &PlanError{
+				Message: generic.Ptr("monthly rules " + other + " and " + key + " name the same days"),
+				Err:     ErrInvalidPlanEntry,
+			}
+		}
+		seen[normalized] = key
+		if _, err := entry.Validate(); err != nil {
+			return &PlanError{
+				Message: generic.Ptr("invalid plan entry for monthly rule " + key),
+				Err:     ErrInvalidPlanEntry,
+				Cause:   err,
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package plan
+
+import (
+	"sort"
+	"time"
+
+	"github.com/julianstephens/go-utils/generic"
+	"github.com/julianstephens/liturgical-time-index/internal"
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+)
+
+// movableEntry is an entry of Plan.Movable with its parsed key.
+type movableEntry struct {
+	key    calendar.AnchorOffset
+	source string
+	entry  PlanEntry
+}
+
+// movableEntries parses the keys of the plan's movable entries. They are ordered nearest anchor first, so that
+// when two keys name the same day ("pentecost" and "easter+49") the one reckoned from the nearer anchor wins.
+func (p *Plan) movableEntries() ([]movableEntry, error) {
+	entries := make([]movableEntry, 0, len(p.Movable))
+	for key, entry := range p.Movable {
+		offset, err := calendar.ParseAnchorOffset(key)
+		if err != nil {
+			return nil, &PlanError{
+				Message: generic.Ptr(
+					"invalid movable date " + key + ": expected an anchor with an optional offset, such as easter+7",
+				),
+				Err:   ErrInvalidPlanEntry,
+				Cause: err,
+			}
+		}
+		entries = append(entries, movableEntry{key: offset, source: key, entry: entry})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := abs(entries[i].key.Offset), abs(entries[j].key.Offset)
+		if a != b {
+			return a < b
+		}
+		return entries[i].source < entries[j].source
+	})
+	return entries, nil
+}
+
+// MovableEntry returns the plan entry for a day reckoned from one of the tradition's anchors, if there is one,
+// with its key. Anchors of the years either side of the date are tried too, since an offset can cross the new year
+// (advent1+40). Keys naming an anchor the tradition does not keep, such as Ash Wednesday in the Ambrosian rite,
+// never match.
+func (p *Plan) MovableEntry(
+	ce *calendar.CalendarEngine,
+	date string,
+	tradition calendar.CalendarTradition,
+) (MatchedEntry, bool, error) {
+	if len(p.Movable) == 0 {
+		return MatchedEntry{}, false, nil
+	}
+	parsed, err := time.Parse(internal.DateFormat, date)
+	if err != nil {
+		return MatchedEntry{}, false, &PlanError{
+			Message: generic.Ptr("invalid date: " + date),
+			Err:     ErrInvalidPlanEntry,
+			Cause:   err,
+		}
+	}
+	entries, err := p.movableEntries()
+	if err != nil {
+		return MatchedEntry{}, false, err
+	}
+
+	anchorsByYear := make(map[int]map[calendar.Anchor]time.Time)
+	for year := parsed.Year() - 1; year <= parsed.Year()+1; year++ {
+		if anchorsByYear[year], err = ce.Anchors(year, tradition); err != nil {
+			return MatchedEntry{}, false, err
+		}
+	}
+	for _, entry := range entries {
+		for _, anchors := range anchorsByYear {
+			anchor, ok := anchors[entry.key.Anchor]
+			if ok && anchor.AddDate(0, 0, entry.key.Offset).Equal(parsed) {
+				return MatchedEntry{PlanEntry: entry.entry, Key: entry.source}, true, nil
+			}
+		}
+	}
+	return MatchedEntry{}, false, nil
+}
+
+// validateMovable checks that every key of the movable entries names an anchor and that the entries are valid.
+func (p *Plan) validateMovable() error {
+	entries, err := p.movableEntries()
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if _, err := entry.entry.Validate(); err != nil {
+			return &PlanError{
+				Message: generic.Ptr("invalid plan entry for movable date " + entry.source),
+				Err:     ErrInvalidPlanEntry,
+				Cause:   err,
+			}
+		}
+	}
+	return nil
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -14,17 +14,35 @@ type Plan struct {
 	Version  int                   `yaml:"version"`
 	Work     string                `yaml:"work"`
 	Witness  string                `yaml:"witness"`
+	Lang     string                `yaml:"lang,omitempty"`
+	Extends  string                `yaml:"extends,omitempty"`
+	Include  []string              `yaml:"include,omitempty"`
 	Defaults PlanEntry             `yaml:"defaults"`
+	Groups   map[string][]string   `yaml:"groups,omitempty"`
 	Seasons  map[string]SeasonPlan `yaml:"seasons"`
+	Anchors  []AnchorEntry         `yaml:"anchors"`
+	Dates    map[string]PlanEntry  `yaml:"dates"`
+	Movable  map[string]PlanEntry  `yaml:"movable"`
+	Monthly  map[string]PlanEntry  `yaml:"monthly"`
+	Rules    []Rule                `yaml:"rules"`
 }
 
 type SeasonPlan struct {
+	Inherits string               `yaml:"inherits,omitempty"`
 	Weekdays map[string]PlanEntry `yaml:"weekdays"`
 	Fallback *PlanEntry           `yaml:"fallback"`
+	Weeks    map[string]WeekPlan  `yaml:"weeks"`
 }
 
-// LoadPlan reads a YAML file from the given path and unmarshals it into a Plan struct.
+// LoadPlan reads a YAML file from the given path and unmarshals it into a Plan struct. A plan may name a base plan
+// with extends and further plans with include, given relative to its own file; they are loaded in that order and
+// merged, with the plan itself layered on top.
 func LoadPlan(planPath string) (*Plan, error) {
+	return loadPlan(planPath, nil)
+}
+
+// readPlan reads a single plan file without resolving its extends and include lists.
+func readPlan(planPath string) (*Plan, error) {
 	var plan Plan
 
 	bytes, err := os.ReadFile(filepath.Clean(planPath))
@@ -59,17 +77,74 @@ func LoadAndValidatePlan(planPath string) (*Plan, error) {
 	return plan, nil
 }
 
+// LoadAndMergePlans loads several plans and merges them in order, later plans winning rule by rule as with
+// extends, then validates the result. A plan that only adjusts another need not be complete on its own.
+func LoadAndMergePlans(planPaths ...string) (*Plan, error) {
+	merged := &Plan{}
+	for _, planPath := range planPaths {
+		p, err := LoadPlan(planPath)
+		if err != nil {
+			return nil, err
+		}
+		merged.merge(p)
+	}
+
+	if err := merged.Validate(); err != nil {
+		return nil, err
+	}
+
+	return merged, nil
+}
+
 // Validate checks the structure and content of the Plan to ensure it meets the required criteria.
-// It verifies that each season has valid weekday entries, that there are no duplicate weekdays,
-// and that all RB references are properly formatted.
+// It verifies that each season has valid weekday entries, that no two weekday keys of the same width overlap,
+// that all RB references are properly formatted, that week plans do not overlap, that date entries are keyed by
+// YYYY-MM-DD or MM-DD, that movable entries are keyed by an anchor with an optional offset, that monthly entries
+// are keyed by a day of the month or an nth weekday, that rules have a when expression that parses, and that
+// anchor overrides name a known anchor or season.
 func (p *Plan) Validate() error {
 	if _, err := p.Defaults.Validate(); err != nil {
 		return &PlanError{
 			Message: generic.Ptr("invalid default plan entry"),
 			Err:     ErrInvalidPlanEntry,
+			Cause:   err,
 		}
 	}
 
+	if p.Lang != "" {
+		if _, err := calendar.ParseLocale(p.Lang); err != nil {
+			return &PlanError{
+				Message: generic.Ptr("invalid plan language " + p.Lang),
+				Err:     ErrInvalidPlanEntry,
+				Cause:   err,
+			}
+		}
+	}
+
+	if err := p.validateAnchors(); err != nil {
+		return err
+	}
+
+	if err := p.validateDates(); err != nil {
+		return err
+	}
+
+	if err := p.validateMovable(); err != nil {
+		return err
+	}
+
+	if err := p.validateMonthly(); err != nil {
+		return err
+	}
+
+	if err := p.validateRules(); err != nil {
+		return err
+	}
+
+	if err := p.validateGroups(); err != nil {
+		return err
+	}
+
 	for seasonName, seasonPlan := range p.Seasons {
 		parsedSeasonName := calendar.LiturgicalSeason(seasonName)
 		if parsedSeasonName == "" {
@@ -79,19 +154,15 @@ func (p *Plan) Validate() error {
 			}
 		}
 
-		weekdaysCovered := make(map[string]bool)
+		if err := validateWeekdayKeys(seasonPlan.Weekdays, "season "+seasonName); err != nil {
+			return err
+		}
 		for weekday, entry := range seasonPlan.Weekdays {
-			if weekdaysCovered[weekday] {
-				return &PlanError{
-					Message: generic.Ptr("duplicate weekday " + weekday + " in season " + seasonName),
-					Err:     ErrInvalidPlanEntry,
-				}
-			}
-			weekdaysCovered[weekday] = true
 			if _, err := entry.Validate(); err != nil {
 				return &PlanError{
 					Message: generic.Ptr("invalid plan entry in season " + seasonName + " for weekday " + weekday),
 					Err:     ErrInvalidPlanEntry,
+					Cause:   err,
 				}
 			}
 		}
@@ -100,19 +171,35 @@ func (p *Plan) Validate() error {
 				return &PlanError{
 					Message: generic.Ptr("invalid fallback plan entry in season " + seasonName),
 					Err:     ErrInvalidPlanEntry,
+					Cause:   err,
 				}
 			}
 		}
+		if err := seasonPlan.validateWeeks(seasonName); err != nil {
+			return err
+		}
+	}
+
+	// Coverage is checked on the plan in effect for each season, after its group and inherited plans are applied.
+	for _, seasonName := range p.plannedSeasons() {
+		seasonPlan, _, err := p.SeasonPlan(seasonName)
+		if err != nil {
+			return err
+		}
+		if err := validateWeekdayKeys(seasonPlan.Weekdays, "season "+seasonName); err != nil {
+			return err
+		}
+		weekdaysCovered := coveredWeekdays(seasonPlan.Weekdays)
 		if len(weekdaysCovered) == 0 && seasonPlan.Fallback == nil {
-			return &PlanError{
-				Message: generic.Ptr("season " + seasonName + " must have at least one weekday entry or a fallback"),
-				Err:     ErrInvalidPlanEntry,
+			// A season planned week by week falls back to the defaults in the weeks it does not cover.
+			if len(seasonPlan.Weeks) > 0 {
+				continue
 			}
-		}
-		if len(weekdaysCovered) > 7 {
 			return &PlanError{
-				Message: generic.Ptr("season " + seasonName + " cannot have more than 7 weekday entries"),
-				Err:     ErrInvalidPlanEntry,
+				Message: generic.Ptr(
+					"season " + seasonName + " must have at least one weekday entry, a fallback or weeks",
+				),
+				Err: ErrInvalidPlanEntry,
 			}
 		}
 		if len(weekdaysCovered) < 7 && seasonPlan.Fallback == nil {
@@ -124,7 +211,7 @@ func (p *Plan) Validate() error {
 			}
 		}
 		if len(weekdaysCovered) == 7 && seasonPlan.Fallback == nil {
-			if err := validateWeekdays(generic.Keys(seasonPlan.Weekdays)); err != nil {
+			if err := validateWeekdays(weekdaysCovered); err != nil {
 				return &PlanError{
 					Message: generic.Ptr("invalid weekday in season " + seasonName + " and no fallback provided"),
 					Err:     ErrInvalidPlanEntry,
@@ -1,10 +1,14 @@
 package plan_test
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
 	"github.com/julianstephens/liturgical-time-index/internal/plan"
 )
 
@@ -141,6 +145,622 @@ func TestValidatePlan_AllWeekdaysNoFallback(t *testing.T) {
 	}
 }
 
+func TestValidatePlan_AnchorOverrides(t *testing.T) {
+	planPath := filepath.Join(testDataDir, "anchors_plan.yml")
+
+	p, err := plan.LoadAndValidatePlan(planPath)
+	if err != nil {
+		t.Fatalf("LoadAndValidatePlan should succeed for a plan with anchor overrides: %v", err)
+	}
+
+	overrides, err := p.AnchorOverrides(planPath)
+	if err != nil {
+		t.Fatalf("AnchorOverrides failed: %v", err)
+	}
+	if len(overrides) != 3 {
+		t.Fatalf("Expected 3 anchor overrides, got %d", len(overrides))
+	}
+	if overrides[0].Anchor != calendar.AnchorEaster || overrides[0].Source != planPath+" (Local custom)" {
+		t.Errorf("Expected an Easter override from %s with its note, got %+v", planPath, overrides[0])
+	}
+	if overrides[1].Season != calendar.Lent || overrides[1].Year() != 2025 {
+		t.Errorf("Expected a 2025 Lent override, got %+v", overrides[1])
+	}
+}
+
+func TestValidatePlan_DuplicateAnchor(t *testing.T) {
+	planPath := filepath.Join(testDataDir, "duplicate_anchor_plan.yml")
+
+	p, err := plan.LoadAndValidatePlan(planPath)
+	if err == nil {
+		t.Error("LoadAndValidatePlan should error for an anchor overridden twice in a year")
+	}
+	if p != nil {
+		t.Error("LoadAndValidatePlan should return nil plan on error")
+	}
+}
+
+func TestValidatePlan_InvalidAnchor(t *testing.T) {
+	planPath := filepath.Join(testDataDir, "invalid_anchor_plan.yml")
+
+	p, err := plan.LoadAndValidatePlan(planPath)
+	if err == nil {
+		t.Error("LoadAndValidatePlan should error for an anchor that cannot be overridden")
+	}
+	if p != nil {
+		t.Error("LoadAndValidatePlan should return nil plan on error")
+	}
+}
+
+func TestValidatePlan_WeekPlans(t *testing.T) {
+	planPath := filepath.Join(testDataDir, "weeks_plan.yml")
+
+	p, err := plan.LoadAndValidatePlan(planPath)
+	if err != nil {
+		t.Fatalf("LoadAndValidatePlan should succeed for a plan with week plans: %v", err)
+	}
+
+	lent := p.Seasons["lent"]
+	lastWeek := func() (int, error) { return 7, nil }
+	matches, err := lent.MatchWeeks(1, lastWeek)
+	if err != nil {
+		t.Fatalf("MatchWeeks failed: %v", err)
+	}
+	if len(matches) != 2 || matches[0].Weekdays["fri"].Cue != "First Friday of Lent" {
+		t.Errorf("Expected week 1 then weeks 1-2 to match week 1, got %+v", matches)
+	}
+	matches, err = lent.MatchWeeks(7, lastWeek)
+	if err != nil {
+		t.Fatalf("MatchWeeks failed: %v", err)
+	}
+	if len(matches) != 1 || matches[0].Fallback == nil || matches[0].Fallback.Cue != "Holy Week" {
+		t.Errorf("Expected the last week plan to match week 7, got %+v", matches)
+	}
+}
+
+func TestValidatePlan_OverlappingWeeks(t *testing.T) {
+	planPath := filepath.Join(testDataDir, "overlapping_weeks_plan.yml")
+
+	p, err := plan.LoadAndValidatePlan(planPath)
+	if err == nil {
+		t.Error("LoadAndValidatePlan should error for overlapping week ranges")
+	}
+	if p != nil {
+		t.Error("LoadAndValidatePlan should return nil plan on error")
+	}
+}
+
+func TestValidatePlan_InvalidWeek(t *testing.T) {
+	planPath := filepath.Join(testDataDir, "invalid_week_plan.yml")
+
+	p, err := plan.LoadAndValidatePlan(planPath)
+	if err == nil {
+		t.Error("LoadAndValidatePlan should error for a week key that is not a number, range or last")
+	}
+	if p != nil {
+		t.Error("LoadAndValidatePlan should return nil plan on error")
+	}
+}
+
+func TestValidatePlan_DateEntries(t *testing.T) {
+	planPath := filepath.Join(testDataDir, "dates_plan.yml")
+
+	p, err := plan.LoadAndValidatePlan(planPath)
+	if err != nil {
+		t.Fatalf("LoadAndValidatePlan should succeed for a plan with date entries: %v", err)
+	}
+
+	testCases := []struct {
+		date        string
+		expectedCue string
+	}{
+		{date: "2025-07-11", expectedCue: "Profession of vows"},
+		{date: "2026-07-11", expectedCue: "Saint Benedict"},
+		{date: "2028-02-29", expectedCue: "Leap day"},
+	}
+	for _, tc := range testCases {
+		entry, ok := p.DateEntry(tc.date)
+		if !ok || entry.Cue != tc.expectedCue {
+			t.Errorf("Expected cue %q for %s, got %q (found %v)", tc.expectedCue, tc.date, entry.Cue, ok)
+		}
+	}
+	if _, ok := p.DateEntry("2025-07-12"); ok {
+		t.Error("Expected no date entry for 2025-07-12")
+	}
+}
+
+func TestValidatePlan_InvalidDate(t *testing.T) {
+	planPath := filepath.Join(testDataDir, "invalid_date_plan.yml")
+
+	p, err := plan.LoadAndValidatePlan(planPath)
+	if err == nil {
+		t.Error("LoadAndValidatePlan should error for a date key that is not a valid date")
+	}
+	if p != nil {
+		t.Error("LoadAndValidatePlan should return nil plan on error")
+	}
+}
+
+func TestValidatePlan_MovableEntries(t *testing.T) {
+	planPath := filepath.Join(testDataDir, "movable_plan.yml")
+
+	p, err := plan.LoadAndValidatePlan(planPath)
+	if err != nil {
+		t.Fatalf("LoadAndValidatePlan should succeed for a plan with movable entries: %v", err)
+	}
+
+	ce := calendar.NewCalendarEngine()
+	testCases := []struct {
+		date        string
+		tradition   calendar.CalendarTradition
+		expectedCue string
+	}{
+		{date: "2025-03-05", tradition: calendar.RomanCalendar, expectedCue: "Ash Wednesday"},
+		{date: "2025-04-27", tradition: calendar.RomanCalendar, expectedCue: "Octave of Easter"},
+		{date: "2025-05-30", tradition: calendar.RomanCalendar, expectedCue: "Pentecost novena"},
+		{date: "2025-06-08", tradition: calendar.RomanCalendar, expectedCue: "Pentecost"},
+		{date: "2025-01-10", tradition: calendar.RomanCalendar, expectedCue: "Forty days of Advent and Christmas"},
+		{date: "2025-03-05", tradition: calendar.AmbrosianCalendar, expectedCue: ""},
+	}
+	for _, tc := range testCases {
+		entry, ok, err := p.MovableEntry(ce, tc.date, tc.tradition)
+		if err != nil {
+			t.Fatalf("MovableEntry failed for %s: %v", tc.date, err)
+		}
+		if ok != (tc.expectedCue != "") || entry.Cue != tc.expectedCue {
+			t.Errorf("Expected cue %q for %s in the %s tradition, got %q (found %v)",
+				tc.expectedCue, tc.date, tc.tradition, entry.Cue, ok)
+		}
+	}
+}
+
+func TestValidatePlan_InvalidMovable(t *testing.T) {
+	planPath := filepath.Join(testDataDir, "invalid_movable_plan.yml")
+
+	p, err := plan.LoadAndValidatePlan(planPath)
+	if err == nil {
+		t.Error("LoadAndValidatePlan should error for a movable key that does not name an anchor")
+	}
+	if p != nil {
+		t.Error("LoadAndValidatePlan should return nil plan on error")
+	}
+}
+
+func TestValidatePlan_RotatingEntries(t *testing.T) {
+	planPath := filepath.Join(testDataDir, "rotation_plan.yml")
+
+	p, err := plan.LoadAndValidatePlan(planPath)
+	if err != nil {
+		t.Fatalf("LoadAndValidatePlan should succeed for a plan with rotating entries: %v", err)
+	}
+
+	fallback := p.Seasons["lent"].Fallback
+	if fallback == nil || !fallback.IsRotating() || len(fallback.Entries) != 3 {
+		t.Fatalf("Expected a rotating fallback with 3 entries, got %+v", fallback)
+	}
+	day := calendar.DayKey{Date: "2025-03-07", Season: calendar.Lent, SeasonWeek: 1}
+	entry, err := fallback.Rotate(day, func() (int, error) { return 5, nil })
+	if err != nil {
+		t.Fatalf("Rotate failed: %v", err)
+	}
+	if entry.Cue != "Read" || entry.Tags == nil || (*entry.Tags)[0] != "lent" {
+		t.Errorf("Expected the second entry with the fallback's tags on day 5, got %+v", entry)
+	}
+}
+
+func TestValidatePlan_InvalidRotation(t *testing.T) {
+	planPath := filepath.Join(testDataDir, "invalid_rotation_plan.yml")
+
+	p, err := plan.LoadAndValidatePlan(planPath)
+	if err == nil {
+		t.Error("LoadAndValidatePlan should error for an unknown rotation policy")
+	}
+	if p != nil {
+		t.Error("LoadAndValidatePlan should return nil plan on error")
+	}
+}
+
+func TestLoadPlan_ExtendsAndInclude(t *testing.T) {
+	planPath := filepath.Join(testDataDir, "personal_plan.yml")
+
+	p, err := plan.LoadAndValidatePlan(planPath)
+	if err != nil {
+		t.Fatalf("LoadAndValidatePlan should succeed for a layered plan: %v", err)
+	}
+
+	if p.Work != "Rule of Saint Benedict" || p.Witness != "english" {
+		t.Errorf("Expected the house work with the personal witness, got %q and %q", p.Work, p.Witness)
+	}
+	if p.Defaults.Cue != "House default" {
+		t.Errorf("Expected the house defaults, got %q", p.Defaults.Cue)
+	}
+	advent := p.Seasons["advent"]
+	if advent.Weekdays["mon"].Cue != "My Advent Monday" || advent.Weekdays["sun"].Cue != "House Advent Sunday" {
+		t.Errorf("Expected Advent weekdays merged entry by entry, got %+v", advent.Weekdays)
+	}
+	if advent.Fallback == nil || advent.Fallback.Cue != "House Advent" {
+		t.Errorf("Expected the house Advent fallback, got %+v", advent.Fallback)
+	}
+	lent := p.Seasons["lent"]
+	if lent.Weekdays["fri"].Cue != "Lenten Friday" || lent.Fallback == nil || lent.Fallback.Cue != "House Lent" {
+		t.Errorf("Expected the included Lent weekday over the house fallback, got %+v", lent)
+	}
+	if _, ok := p.DateEntry("2025-07-11"); !ok {
+		t.Error("Expected the house date entry")
+	}
+
+	overrides, err := p.AnchorOverrides("plan file")
+	if err != nil {
+		t.Fatalf("AnchorOverrides failed: %v", err)
+	}
+	if len(overrides) != 1 || overrides[0].Source != planPath+" (Personal)" {
+		t.Errorf("Expected the personal Easter override to replace the house one, got %+v", overrides)
+	}
+}
+
+func TestLoadPlan_IncludeCycle(t *testing.T) {
+	planPath := filepath.Join(testDataDir, "cycle_a_plan.yml")
+
+	p, err := plan.LoadPlan(planPath)
+	if !errors.Is(err, plan.ErrPlanCycle) {
+		t.Errorf("LoadPlan should report a cycle, got %v", err)
+	}
+	if p != nil {
+		t.Error("LoadPlan should return nil plan on error")
+	}
+}
+
+func TestLoadAndMergePlans(t *testing.T) {
+	housePath := filepath.Join(testDataDir, "house_plan.yml")
+	fragmentPath := filepath.Join(testDataDir, "lent_fragment_plan.yml")
+
+	if _, err := plan.LoadAndValidatePlan(fragmentPath); err == nil {
+		t.Error("LoadAndValidatePlan should error for a fragment without a Lent fallback")
+	}
+
+	p, err := plan.LoadAndMergePlans(housePath, fragmentPath)
+	if err != nil {
+		t.Fatalf("LoadAndMergePlans should succeed when the fragment completes the house plan: %v", err)
+	}
+	lent := p.Seasons["lent"]
+	if lent.Weekdays["fri"].Cue != "Lenten Friday" || lent.Fallback == nil || lent.Fallback.Cue != "House Lent" {
+		t.Errorf("Expected the fragment's Lent weekday over the house fallback, got %+v", lent)
+	}
+
+	overrides, err := p.AnchorOverrides("plan file")
+	if err != nil {
+		t.Fatalf("AnchorOverrides failed: %v", err)
+	}
+	if len(overrides) != 1 || overrides[0].Source != housePath+" (House)" {
+		t.Errorf("Expected the house Easter override from %s, got %+v", housePath, overrides)
+	}
+}
+
+func TestValidatePlan_Slots(t *testing.T) {
+	planPath := filepath.Join(testDataDir, "slots_plan.yml")
+
+	p, err := plan.LoadAndValidatePlan(planPath)
+	if err != nil {
+		t.Fatalf("LoadAndValidatePlan should succeed for a plan with slots: %v", err)
+	}
+
+	formatted, err := p.Defaults.Validate()
+	if err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	expected := []struct {
+		name     string
+		time     string
+		duration time.Duration
+	}{
+		{"morning", "06:00", 45 * time.Minute},
+		{"midday", "12:30", 15 * time.Minute},
+		{"evening", "18:00", 30 * time.Minute},
+		{"anytime", "", 0},
+	}
+	if len(formatted.Slots) != len(expected) {
+		t.Fatalf("Expected %d slots, got %d", len(expected), len(formatted.Slots))
+	}
+	for i, slot := range formatted.Slots {
+		if slot.Name != expected[i].name || slot.Time != expected[i].time || slot.Duration != expected[i].duration {
+			t.Errorf("Expected slot %d to be %+v, got %+v", i, expected[i], slot)
+		}
+	}
+	if label := formatted.Slots[0].Label(); label != "morning (06:00, 45m)" {
+		t.Errorf("Expected label %q, got %q", "morning (06:00, 45m)", label)
+	}
+	if summary := formatted.Summary(); summary != "morning: Lectio; midday: Examen; evening: Chapter reading; "+
+		"anytime: Manual labour" {
+		t.Errorf("Unexpected summary %q", summary)
+	}
+}
+
+func TestValidatePlan_InvalidSlot(t *testing.T) {
+	planPath := filepath.Join(testDataDir, "invalid_slot_plan.yml")
+
+	p, err := plan.LoadAndValidatePlan(planPath)
+	if err == nil {
+		t.Error("LoadAndValidatePlan should error for a slot time that is not HH:MM")
+	}
+	if p != nil {
+		t.Error("LoadAndValidatePlan should return nil plan on error")
+	}
+}
+
+func TestLoadPlan_MultilingualCues(t *testing.T) {
+	planPath := filepath.Join(testDataDir, "multilingual_plan.yml")
+
+	p, err := plan.LoadAndValidatePlan(planPath)
+	if err != nil {
+		t.Fatalf("LoadAndValidatePlan should succeed for a plan with translated cues: %v", err)
+	}
+
+	if p.Lang != "la" {
+		t.Errorf("Expected plan language la, got %q", p.Lang)
+	}
+	if p.Defaults.Cue != "Silence" || p.Defaults.Cues["es"] != "Silencio" {
+		t.Errorf("Expected the English cue with its translations, got %q %v", p.Defaults.Cue, p.Defaults.Cues)
+	}
+	morning := p.Defaults.Slots["morning"]
+	if morning.Cue != "Lectio" || morning.Cues["it"] != "Lettura" || morning.Time != "05:30" {
+		t.Errorf("Expected the slot's cue, translations and time, got %+v", morning)
+	}
+	if rule := p.Rules[0]; rule.When != "weekday == sun" || rule.Cues["de"] != "Sonntagsruhe" {
+		t.Errorf("Expected the rule's expression and translations, got %+v", rule)
+	}
+}
+
+func TestValidatePlan_InvalidCueLanguage(t *testing.T) {
+	planPath := filepath.Join(testDataDir, "invalid_cue_language_plan.yml")
+
+	p, err := plan.LoadAndValidatePlan(planPath)
+	if err == nil {
+		t.Error("LoadAndValidatePlan should error for a cue in an invalid language")
+	}
+	if p != nil {
+		t.Error("LoadAndValidatePlan should return nil plan on error")
+	}
+}
+
+func TestValidatePlan_CueTemplates(t *testing.T) {
+	planPath := filepath.Join(testDataDir, "cue_templates_plan.yml")
+
+	p, err := plan.LoadAndValidatePlan(planPath)
+	if err != nil {
+		t.Fatalf("LoadAndValidatePlan should succeed for a plan with cue templates: %v", err)
+	}
+
+	key := calendar.DayKey{Date: "2025-07-18", Season: calendar.Ordinary, SeasonWeek: 11, Weekday: calendar.Friday}
+	cue, err := plan.ExpandCue(p.Defaults.Cue, plan.NewCueData(nil, key, nil, calendar.English))
+	if err != nil {
+		t.Fatalf("ExpandCue failed: %v", err)
+	}
+	if expected := "Friday of the 11th week of Ordinary Time"; cue != expected {
+		t.Errorf("Expected cue %q, got %q", expected, cue)
+	}
+}
+
+func TestValidatePlan_InvalidCueTemplate(t *testing.T) {
+	testCases := []string{
+		"Day {{.DayOfSaeson}}",
+		"{{.SeasonWeek | roman}} week",
+		`{{.DaysUntil "nowhere"}} days`,
+		"{{.Season",
+	}
+	for _, cue := range testCases {
+		entry := plan.PlanEntry{Cue: cue, Rb: []string{"RB 1.1"}}
+		if _, err := entry.Validate(); !errors.Is(err, plan.ErrInvalidPlanEntry) {
+			t.Errorf("Expected plan.ErrInvalidPlanEntry for cue %q, got %v", cue, err)
+		}
+	}
+
+	planPath := filepath.Join(testDataDir, "invalid_cue_template_plan.yml")
+	p, err := plan.LoadAndValidatePlan(planPath)
+	if err == nil {
+		t.Error("LoadAndValidatePlan should error for a cue with an unknown variable")
+	} else if !strings.Contains(err.Error(), "DayOfSaeson") {
+		t.Errorf("Expected the error to name the invalid cue, got %v", err)
+	}
+	if p != nil {
+		t.Error("LoadAndValidatePlan should return nil plan on error")
+	}
+}
+
+func TestValidatePlan_Rules(t *testing.T) {
+	planPath := filepath.Join(testDataDir, "rules_plan.yml")
+
+	p, err := plan.LoadAndValidatePlan(planPath)
+	if err != nil {
+		t.Fatalf("LoadAndValidatePlan should succeed for a plan with rules: %v", err)
+	}
+	if len(p.Rules) != 2 || p.Rules[0].Cue != "Late Lenten Friday" {
+		t.Fatalf("Expected the rules in order, got %+v", p.Rules)
+	}
+
+	ce := calendar.NewCalendarEngine()
+	testCases := []struct {
+		date        string
+		tags        []string
+		expectedCue string
+	}{
+		{date: "2025-04-04", tags: []string{"fast"}, expectedCue: "Late Lenten Friday"},
+		{date: "2025-03-07", tags: []string{"fast"}, expectedCue: "Fast day"},
+		{date: "2025-03-07", tags: nil, expectedCue: ""},
+	}
+	for _, tc := range testCases {
+		day, err := ce.GetRomanDay(tc.date, calendar.RomanCalendar)
+		if err != nil {
+			t.Fatalf("GetRomanDay failed: %v", err)
+		}
+		entry, ok, err := p.RuleEntry(ce, *day, tc.tags)
+		if err != nil {
+			t.Fatalf("RuleEntry failed: %v", err)
+		}
+		if ok != (tc.expectedCue != "") || entry.Cue != tc.expectedCue {
+			t.Errorf("Expected cue %q for %s with tags %v, got %q (found %v)", tc.expectedCue, tc.date, tc.tags,
+				entry.Cue, ok)
+		}
+	}
+}
+
+func TestValidatePlan_InvalidRule(t *testing.T) {
+	planPath := filepath.Join(testDataDir, "invalid_rules_plan.yml")
+
+	p, err := plan.LoadAndValidatePlan(planPath)
+	if err == nil {
+		t.Error("LoadAndValidatePlan should error for a rule whose when expression does not parse")
+	}
+	if p != nil {
+		t.Error("LoadAndValidatePlan should return nil plan on error")
+	}
+}
+
+func TestValidatePlan_MonthlyEntries(t *testing.T) {
+	planPath := filepath.Join(testDataDir, "monthly_plan.yml")
+
+	p, err := plan.LoadAndValidatePlan(planPath)
+	if err != nil {
+		t.Fatalf("LoadAndValidatePlan should succeed for a plan with monthly entries: %v", err)
+	}
+
+	testCases := []struct {
+		date        string
+		expectedCue string
+	}{
+		{date: "2025-08-01", expectedCue: "First Friday"},
+		{date: "2025-08-15", expectedCue: "Mid-month"},
+		{date: "2025-08-29", expectedCue: "Fifth Friday"},
+		{date: "2025-08-31", expectedCue: "Recollection Sunday"},
+		{date: "2025-09-26", expectedCue: "Last Friday"},
+		{date: "2025-08-08", expectedCue: ""},
+	}
+	for _, tc := range testCases {
+		entry, ok := p.MonthlyEntry(tc.date)
+		if ok != (tc.expectedCue != "") {
+			t.Fatalf("Expected a monthly entry for %s: %v, got %v", tc.date, tc.expectedCue != "", ok)
+		}
+		if entry.Cue != tc.expectedCue {
+			t.Errorf("Expected cue %q for %s, got %q", tc.expectedCue, tc.date, entry.Cue)
+		}
+	}
+}
+
+func TestValidatePlan_InvalidMonthly(t *testing.T) {
+	planPath := filepath.Join(testDataDir, "invalid_monthly_plan.yml")
+
+	p, err := plan.LoadAndValidatePlan(planPath)
+	if err == nil {
+		t.Error("LoadAndValidatePlan should error for an invalid monthly rule")
+	}
+	if p != nil {
+		t.Error("LoadAndValidatePlan should return nil plan on error")
+	}
+}
+
+func TestValidatePlan_WeekdayRanges(t *testing.T) {
+	planPath := filepath.Join(testDataDir, "weekday_ranges_plan.yml")
+
+	p, err := plan.LoadAndValidatePlan(planPath)
+	if err != nil {
+		t.Fatalf("LoadAndValidatePlan should succeed for a plan with weekday ranges: %v", err)
+	}
+
+	testCases := []struct {
+		season      string
+		weekday     calendar.Weekday
+		expectedCue string
+	}{
+		{season: "ordinary", weekday: calendar.Monday, expectedCue: "Feria"},
+		{season: "ordinary", weekday: calendar.Friday, expectedCue: "Friday"},
+		{season: "ordinary", weekday: calendar.Sunday, expectedCue: "Weekend"},
+		{season: "lent", weekday: calendar.Wednesday, expectedCue: "Lent"},
+		{season: "lent", weekday: calendar.Monday, expectedCue: "Long weekend of Lent"},
+		{season: "lent", weekday: calendar.Saturday, expectedCue: "Lenten weekend"},
+	}
+	for _, tc := range testCases {
+		entry, ok, err := p.Seasons[tc.season].Weekday(tc.weekday)
+		if err != nil || !ok {
+			t.Fatalf("Expected an entry for %s %s, got %v (found %v)", tc.season, tc.weekday, err, ok)
+		}
+		if entry.Cue != tc.expectedCue {
+			t.Errorf("Expected cue %q for %s %s, got %q", tc.expectedCue, tc.season, tc.weekday, entry.Cue)
+		}
+	}
+}
+
+func TestValidatePlan_OverlappingWeekdays(t *testing.T) {
+	planPath := filepath.Join(testDataDir, "overlapping_weekdays_plan.yml")
+
+	p, err := plan.LoadAndValidatePlan(planPath)
+	if err == nil {
+		t.Error("LoadAndValidatePlan should error for weekday ranges of the same width that overlap")
+	}
+	if p != nil {
+		t.Error("LoadAndValidatePlan should return nil plan on error")
+	}
+}
+
+func TestValidatePlan_SeasonGroups(t *testing.T) {
+	planPath := filepath.Join(testDataDir, "groups_plan.yml")
+
+	p, err := plan.LoadAndValidatePlan(planPath)
+	if err != nil {
+		t.Fatalf("LoadAndValidatePlan should succeed for a plan with season groups: %v", err)
+	}
+
+	testCases := []struct {
+		season           string
+		weekday          string
+		expectedCue      string
+		expectedFallback string
+	}{
+		{season: "advent", weekday: "fri", expectedCue: "Penitential Friday", expectedFallback: "Penitential"},
+		{season: "lent", weekday: "fri", expectedCue: "Penitential Friday", expectedFallback: "Penitential"},
+		{season: "lent", weekday: "wed", expectedCue: "Lenten Wednesday", expectedFallback: "Penitential"},
+		{season: "triduum", weekday: "wed", expectedCue: "Lenten Wednesday", expectedFallback: "Penitential"},
+		{season: "triduum", weekday: "thu", expectedCue: "Holy Thursday", expectedFallback: "Penitential"},
+	}
+	for _, tc := range testCases {
+		seasonPlan, ok, err := p.SeasonPlan(tc.season)
+		if err != nil || !ok {
+			t.Fatalf("Expected a plan for %s, got %v (found %v)", tc.season, err, ok)
+		}
+		if cue := seasonPlan.Weekdays[tc.weekday].Cue; cue != tc.expectedCue {
+			t.Errorf("Expected cue %q for %s %s, got %q", tc.expectedCue, tc.season, tc.weekday, cue)
+		}
+		if seasonPlan.Fallback == nil || seasonPlan.Fallback.Cue != tc.expectedFallback {
+			t.Errorf("Expected fallback %q for %s, got %+v", tc.expectedFallback, tc.season, seasonPlan.Fallback)
+		}
+	}
+	if _, ok, _ := p.SeasonPlan("eastertide"); ok {
+		t.Error("Expected no plan for eastertide")
+	}
+}
+
+func TestValidatePlan_InheritsCycle(t *testing.T) {
+	planPath := filepath.Join(testDataDir, "inherits_cycle_plan.yml")
+
+	p, err := plan.LoadAndValidatePlan(planPath)
+	if !errors.Is(err, plan.ErrPlanCycle) {
+		t.Errorf("LoadAndValidatePlan should report a cycle, got %v", err)
+	}
+	if p != nil {
+		t.Error("LoadAndValidatePlan should return nil plan on error")
+	}
+}
+
+func TestValidatePlan_SeasonInTwoGroups(t *testing.T) {
+	planPath := filepath.Join(testDataDir, "invalid_group_plan.yml")
+
+	p, err := plan.LoadAndValidatePlan(planPath)
+	if err == nil {
+		t.Error("LoadAndValidatePlan should error for a season in two groups")
+	}
+	if p != nil {
+		t.Error("LoadAndValidatePlan should return nil plan on error")
+	}
+}
+
 func init() {
 	// Create valid plan
 	createTestFileIfNotExists("valid_plan.yml", `
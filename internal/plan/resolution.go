@@ -0,0 +1,45 @@
+package plan
+
+// Resolution names the part of a plan that gave a day its entry.
+type Resolution string
+
+const (
+	ResolvedDate         Resolution = "date"
+	ResolvedMovable      Resolution = "movable"
+	ResolvedAnnual       Resolution = "annual"
+	ResolvedMonthly      Resolution = "monthly"
+	ResolvedRule         Resolution = "rule"
+	ResolvedWeek         Resolution = "week"
+	ResolvedWeekFallback Resolution = "week-fallback"
+	ResolvedWeekday      Resolution = "weekday"
+	ResolvedFallback     Resolution = "fallback"
+	ResolvedDefault      Resolution = "default"
+)
+
+// MatchedEntry is a plan entry with the key that selected it, such as "03-21", "easter+7", "first-fri", a rule's
+// when expression or a weekday key.
+type MatchedEntry struct {
+	PlanEntry
+	Key string
+}
+
+// MatchedWeek is a week plan with the key of SeasonPlan.Weeks that selected it.
+type MatchedWeek struct {
+	WeekPlan
+	Key string
+}
+
+// Resolutions lists the resolutions from the most specific part of a plan to the least, the order Compile tries
+// them in.
+var Resolutions = []Resolution{
+	ResolvedDate,
+	ResolvedMovable,
+	ResolvedAnnual,
+	ResolvedMonthly,
+	ResolvedRule,
+	ResolvedWeek,
+	ResolvedWeekFallback,
+	ResolvedWeekday,
+	ResolvedFallback,
+	ResolvedDefault,
+}
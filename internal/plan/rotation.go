@@ -0,0 +1,110 @@
+package plan
+
+import (
+	"hash/fnv"
+
+	"github.com/julianstephens/go-utils/generic"
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+)
+
+// Rotation is the policy a rotating entry uses to pick one of its entries for a day.
+type Rotation string
+
+const (
+	// RotateSequential picks the entries in order, one per day of the season.
+	RotateSequential Rotation = "sequential"
+	// RotateWeekly picks the entries in order, one per week of the season.
+	RotateWeekly Rotation = "weekly"
+	// RotateRandom picks an entry pseudo-randomly, seeded from the date, so a day always gets the same entry.
+	RotateRandom Rotation = "random"
+)
+
+// IsRotating reports whether the entry holds a list of entries to rotate through rather than a cue of its own.
+func (e *PlanEntry) IsRotating() bool {
+	return len(e.Entries) > 0
+}
+
+// Rotate returns the entry to use for a day: the entry itself, or, for a rotating entry, the entry its rotation
+// picks. A picked entry without tags takes the rotating entry's tags, and its rotating slots are rotated in turn.
+// dayOfSeason is called only by sequential rotations, to find the position of the day in its season.
+func (e PlanEntry) Rotate(key calendar.DayKey, dayOfSeason func() (int, error)) (PlanEntry, error) {
+	if !e.IsRotating() {
+		return e.rotateSlots(key, dayOfSeason)
+	}
+
+	var index int
+	switch e.Rotation {
+	case RotateWeekly:
+		index = max(key.SeasonWeek-1, 0)
+	case RotateRandom:
+		hash := fnv.New32a()
+		_, _ = hash.Write([]byte(key.Date))
+		index = int(hash.Sum32() % uint32(len(e.Entries)))
+	default:
+		day, err := dayOfSeason()
+		if err != nil {
+			return PlanEntry{}, err
+		}
+		index = max(day-1, 0)
+	}
+
+	picked := e.Entries[index%len(e.Entries)]
+	if picked.Tags == nil {
+		picked.Tags = e.Tags
+	}
+	return picked.Rotate(key, dayOfSeason)
+}
+
+// rotateSlots returns the entry with each of its slots rotated for the day.
+func (e PlanEntry) rotateSlots(key calendar.DayKey, dayOfSeason func() (int, error)) (PlanEntry, error) {
+	if len(e.Slots) == 0 {
+		return e, nil
+	}
+	slots := make(map[string]SlotEntry, len(e.Slots))
+	for name, slot := range e.Slots {
+		rotated, err := slot.PlanEntry.Rotate(key, dayOfSeason)
+		if err != nil {
+			return PlanEntry{}, err
+		}
+		slot.PlanEntry = rotated
+		slots[name] = slot
+	}
+	e.Slots = slots
+	return e, nil
+}
+
+// validateRotation checks a rotating entry: that its policy is known, that it has no cue or references of its
+// own, and that the entries it rotates through are valid.
+func (e *PlanEntry) validateRotation() error {
+	switch e.Rotation {
+	case "", RotateSequential, RotateWeekly, RotateRandom:
+	default:
+		return &PlanError{
+			Message: generic.Ptr(
+				"invalid rotation " + string(e.Rotation) + ": expected one of sequential, weekly, random",
+			),
+			Err: ErrInvalidPlanEntry,
+		}
+	}
+	if !e.IsRotating() {
+		if e.Rotation != "" {
+			return &PlanError{
+				Message: generic.Ptr("rotation " + string(e.Rotation) + " has no entries to rotate through"),
+				Err:     ErrInvalidPlanEntry,
+			}
+		}
+		return nil
+	}
+	if e.Cue != "" || len(e.Rb) > 0 || len(e.Slots) > 0 {
+		return &PlanError{
+			Message: generic.Ptr("a rotating entry cannot have a cue, RB references or slots of its own"),
+			Err:     ErrInvalidPlanEntry,
+		}
+	}
+	for _, entry := range e.Entries {
+		if _, err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
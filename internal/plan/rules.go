@@ -0,0 +1,71 @@
+package plan
+
+import (
+	"strconv"
+
+	"github.com/julianstephens/go-utils/generic"
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+	"github.com/julianstephens/liturgical-time-index/internal/query"
+)
+
+// Rule is an entry of Plan.Rules: a when expression in the query language, such as
+// `season == "lent" && week >= 5 && weekday in ["fri"]`, and the entry for the days it matches.
+type Rule struct {
+	When      string `yaml:"when"`
+	PlanEntry `yaml:",inline"`
+}
+
+// RuleEntry returns the entry of the first rule whose when expression matches the day, keyed by the expression.
+// tags are the tags of the entry the seasons give the day, for rules that test them (`tags == "fast"`).
+func (p *Plan) RuleEntry(
+	ce *calendar.CalendarEngine,
+	day calendar.DayKey,
+	tags []string,
+) (MatchedEntry, bool, error) {
+	for i, rule := range p.Rules {
+		q, err := query.Parse(rule.When)
+		if err != nil {
+			return MatchedEntry{}, false, ruleError(i, rule, err)
+		}
+		ok, err := q.MatchTagged(ce, day, tags)
+		if err != nil {
+			return MatchedEntry{}, false, ruleError(i, rule, err)
+		}
+		if ok {
+			return MatchedEntry{PlanEntry: rule.PlanEntry, Key: rule.When}, true, nil
+		}
+	}
+	return MatchedEntry{}, false, nil
+}
+
+// validateRules checks that every rule has a when expression that parses and a valid entry.
+func (p *Plan) validateRules() error {
+	for i, rule := range p.Rules {
+		if rule.When == "" {
+			return &PlanError{
+				Message: generic.Ptr("rule " + strconv.Itoa(i+1) + " must have a when expression"),
+				Err:     ErrInvalidPlanEntry,
+			}
+		}
+		if _, err := query.Parse(rule.When); err != nil {
+			return ruleError(i, rule, err)
+		}
+		if _, err := rule.PlanEntry.Validate(); err != nil {
+			return &PlanError{
+				Message: generic.Ptr("invalid plan entry for rule " + strconv.Itoa(i+1)),
+				Err:     ErrInvalidPlanEntry,
+				Cause:   err,
+			}
+		}
+	}
+	return nil
+}
+
+// ruleError reports an error in the when expression of the rule at index i.
+func ruleError(i int, rule Rule, err error) error {
+	return &PlanError{
+		Message: generic.Ptr("invalid when expression in rule " + strconv.Itoa(i+1) + ": " + rule.When),
+		Err:     ErrInvalidPlanEntry,
+		Cause:   err,
+	}
+}
@@ -0,0 +1,146 @@
+package plan
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/julianstephens/canonref/rbref"
+	"github.com/julianstephens/go-utils/generic"
+)
+
+// slotTimeFormat is the layout of a slot's time of day.
+const slotTimeFormat = "15:04"
+
+// defaultSlotDuration is the length of a timed slot that does not give its own duration.
+const defaultSlotDuration = 30 * time.Minute
+
+// defaultSlotTimes are the times of day of the common slot names, used when a slot does not give its own time.
+var defaultSlotTimes = map[string]string{
+	"morning":   "06:00",
+	"midday":    "12:00",
+	"afternoon": "15:00",
+	"evening":   "18:00",
+	"night":     "21:00",
+}
+
+// SlotEntry is one practice of a day, such as morning lectio or an evening chapter reading. Time is the time of
+// day (HH:MM) and Duration its length (e.g. "45m"); a slot without a time, whose name is not one of the common
+// slot names, is kept for the whole day.
+type SlotEntry struct {
+	PlanEntry `yaml:",inline"`
+	Time      string `yaml:"time,omitempty"`
+	Duration  string `yaml:"duration,omitempty"`
+}
+
+// FormattedSlot is a slot of a compiled entry. Time is empty for a slot kept for the whole day.
+type FormattedSlot struct {
+	Name     string            `yaml:"name"`
+	Time     string            `yaml:"time,omitempty"`
+	Duration time.Duration     `yaml:"duration,omitempty"`
+	Cue      string            `yaml:"cue"`
+	Cues     map[string]string `yaml:"-"`
+	Rb       []rbref.RbRef     `yaml:"rb"`
+	Tags     *[]string         `yaml:"tags,omitempty"`
+}
+
+// formatSlots validates the entry's slots and formats them in order of their time of day, the untimed slots last.
+func (e *PlanEntry) formatSlots() ([]FormattedSlot, error) {
+	slots := make([]FormattedSlot, 0, len(e.Slots))
+	for name, slot := range e.Slots {
+		if len(slot.Slots) > 0 {
+			return nil, &PlanError{
+				Message: generic.Ptr("slot " + name + " cannot have slots of its own"),
+				Err:     ErrInvalidPlanEntry,
+			}
+		}
+		formatted, err := slot.PlanEntry.Validate()
+		if err != nil {
+			return nil, err
+		}
+
+		clock := slot.Time
+		if clock == "" {
+			clock = defaultSlotTimes[name]
+		}
+		if clock != "" {
+			if _, err := time.Parse(slotTimeFormat, clock); err != nil {
+				return nil, &PlanError{
+					Message: generic.Ptr("invalid time " + clock + " for slot " + name + ": expected HH:MM"),
+					Err:     ErrInvalidPlanEntry,
+					Cause:   err,
+				}
+			}
+		}
+
+		var duration time.Duration
+		switch {
+		case slot.Duration != "":
+			duration, err = time.ParseDuration(slot.Duration)
+			if err != nil || duration <= 0 {
+				return nil, &PlanError{
+					Message: generic.Ptr(
+						"invalid duration " + slot.Duration + " for slot " + name + ": expected e.g. 45m",
+					),
+					Err:   ErrInvalidPlanEntry,
+					Cause: err,
+				}
+			}
+		case clock != "":
+			duration = defaultSlotDuration
+		}
+
+		slots = append(slots, FormattedSlot{
+			Name:     name,
+			Time:     clock,
+			Duration: duration,
+			Cue:      formatted.Cue,
+			Cues:     formatted.Cues,
+			Rb:       formatted.Rb,
+			Tags:     formatted.Tags,
+		})
+	}
+
+	sort.Slice(slots, func(i, j int) bool {
+		a, b := slots[i], slots[j]
+		if (a.Time == "") != (b.Time == "") {
+			return b.Time == ""
+		}
+		if a.Time != b.Time {
+			return a.Time < b.Time
+		}
+		return a.Name < b.Name
+	})
+	return slots, nil
+}
+
+// Summary returns the entry's cue or, for an entry divided into slots without a cue of its own, the cues of its
+// slots.
+func (e *FormattedEntry) Summary() string {
+	if e.Cue != "" || len(e.Slots) == 0 {
+		return e.Cue
+	}
+	return strings.Join(generic.Map(e.Slots, func(slot FormattedSlot) string {
+		return slot.Name + ": " + slot.Cue
+	}), "; ")
+}
+
+// Label returns the slot's name with, for a timed slot, its time and length, e.g. "morning (06:00, 45m)".
+func (s FormattedSlot) Label() string {
+	if s.Time == "" {
+		return s.Name
+	}
+	return s.Name + " (" + s.Time + ", " + formatDuration(s.Duration) + ")"
+}
+
+// formatDuration renders a slot's length in minutes or hours, e.g. "45m" or "1h30m".
+func formatDuration(d time.Duration) string {
+	formatted := d.String()
+	if strings.HasSuffix(formatted, "m0s") {
+		formatted = strings.TrimSuffix(formatted, "0s")
+	}
+	if strings.HasSuffix(formatted, "h0m") {
+		formatted = strings.TrimSuffix(formatted, "0m")
+	}
+	return formatted
+}
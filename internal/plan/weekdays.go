@@ -0,0 +1,228 @@
+package plan
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/julianstephens/go-utils/generic"
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+)
+
+// weekOrder is the order of the days in a weekday range, starting from Monday as the plans do.
+var weekOrder = []calendar.Weekday{
+	calendar.Monday,
+	calendar.Tuesday,
+	calendar.Wednesday,
+	calendar.Thursday,
+	calendar.Friday,
+	calendar.Saturday,
+	calendar.Sunday,
+}
+
+// weekdayGroups are the named sets of days a weekday key can give.
+var weekdayGroups = map[string][]calendar.Weekday{
+	"weekdays": weekOrder[:5],
+	"weekend":  weekOrder[5:],
+}
+
+// weekdaySelector is a parsed key of a weekdays map.
+type weekdaySelector struct {
+	key  string
+	days []calendar.Weekday
+}
+
+// parseWeekdaySelector parses a weekday key: a day ("fri"), a range ("mon-fri", which may wrap past Sunday as in
+// "fri-mon"), a named group ("weekdays", "weekend") or a comma-separated list of these ("sat,sun").
+func parseWeekdaySelector(key string) (weekdaySelector, error) {
+	selector := weekdaySelector{key: key}
+	for _, part := range strings.Split(strings.ToLower(key), ",") {
+		days, err := parseWeekdayPart(strings.TrimSpace(part))
+		if err != nil {
+			return weekdaySelector{}, &PlanError{
+				Message: generic.Ptr(
+					"invalid weekday " + key + ": expected a day (sun, mon, tue, wed, thu, fri, sat), a range " +
+						"such as mon-fri, weekdays, weekend, or a comma-separated list of these",
+				),
+				Err:   ErrInvalidPlanEntry,
+				Cause: err,
+			}
+		}
+		for _, day := range days {
+			if slices.Contains(selector.days, day) {
+				return weekdaySelector{}, &PlanError{
+					Message: generic.Ptr("weekday " + key + " names " + string(day) + " more than once"),
+					Err:     ErrInvalidPlanEntry,
+				}
+			}
+			selector.days = append(selector.days, day)
+		}
+	}
+	return selector, nil
+}
+
+// parseWeekdayPart parses one element of a weekday key.
+func parseWeekdayPart(part string) ([]calendar.Weekday, error) {
+	if days, ok := weekdayGroups[part]; ok {
+		return days, nil
+	}
+	fromText, toText, isRange := strings.Cut(part, "-")
+	from, err := parseWeekdayAbbreviation(fromText)
+	if err != nil {
+		return nil, err
+	}
+	if !isRange {
+		return []calendar.Weekday{from}, nil
+	}
+	to, err := parseWeekdayAbbreviation(toText)
+	if err != nil {
+		return nil, err
+	}
+	if from == to {
+		return nil, &PlanError{
+			Message: generic.Ptr("weekday range " + part + " starts and ends on the same day"),
+			Err:     ErrInvalidPlanEntry,
+		}
+	}
+	days := []calendar.Weekday{}
+	for i := slices.Index(weekOrder, from); ; i = (i + 1) % len(weekOrder) {
+		days = append(days, weekOrder[i])
+		if weekOrder[i] == to {
+			return days, nil
+		}
+	}
+}
+
+// parseWeekdayAbbreviation parses a day given as the three-letter abbreviation plans use.
+func parseWeekdayAbbreviation(text string) (calendar.Weekday, error) {
+	parsed, err := calendar.ParseWeekday(text)
+	if err != nil {
+		return "", err
+	}
+	if string(parsed) != strings.TrimSpace(text) {
+		return "", &PlanError{
+			Message: generic.Ptr("invalid weekday " + text + ": expected one of sun, mon, tue, wed, thu, fri, sat"),
+			Err:     ErrInvalidPlanEntry,
+		}
+	}
+	return parsed, nil
+}
+
+// covers reports whether the selector includes every one of the given days.
+func (s weekdaySelector) covers(days []calendar.Weekday) bool {
+	for _, day := range days {
+		if !slices.Contains(s.days, day) {
+			return false
+		}
+	}
+	return true
+}
+
+// overlaps reports whether the two selectors share a day.
+func (s weekdaySelector) overlaps(other weekdaySelector) bool {
+	return slices.ContainsFunc(s.days, func(day calendar.Weekday) bool { return slices.Contains(other.days, day) })
+}
+
+// parseWeekdaySelectors parses the keys of a weekdays map and sorts them from the most specific to the least:
+// fewer days first, then by key so that the order is stable.
+func parseWeekdaySelectors(weekdays map[string]PlanEntry) ([]weekdaySelector, error) {
+	selectors := make([]weekdaySelector, 0, len(weekdays))
+	for key := range weekdays {
+		selector, err := parseWeekdaySelector(key)
+		if err != nil {
+			return nil, err
+		}
+		selectors = append(selectors, selector)
+	}
+	sort.Slice(selectors, func(i, j int) bool {
+		if len(selectors[i].days) != len(selectors[j].days) {
+			return len(selectors[i].days) < len(selectors[j].days)
+		}
+		return selectors[i].key < selectors[j].key
+	})
+	return selectors, nil
+}
+
+// matchWeekday returns the entry for the day from a weekdays map with its key: the entry whose key names the fewest
+// days among those that include it, so that "fri" wins over "mon-fri" and "mon-fri" over "sun-sat".
+func matchWeekday(weekdays map[string]PlanEntry, day calendar.Weekday) (MatchedEntry, bool, error) {
+	selectors, err := parseWeekdaySelectors(weekdays)
+	if err != nil {
+		return MatchedEntry{}, false, err
+	}
+	for _, selector := range selectors {
+		if slices.Contains(selector.days, day) {
+			return MatchedEntry{PlanEntry: weekdays[selector.key], Key: selector.key}, true, nil
+		}
+	}
+	return MatchedEntry{}, false, nil
+}
+
+// Weekday returns the season's entry for the day of the week, matching range and group keys.
+func (s SeasonPlan) Weekday(day calendar.Weekday) (MatchedEntry, bool, error) {
+	return matchWeekday(s.Weekdays, day)
+}
+
+// Weekday returns the week plan's entry for the day of the week, matching range and group keys.
+func (w WeekPlan) Weekday(day calendar.Weekday) (MatchedEntry, bool, error) {
+	return matchWeekday(w.Weekdays, day)
+}
+
+// validateWeekdayKeys checks that the keys of a weekdays map parse and that no two keys naming the same number of
+// days share a day, since neither would be more specific than the other. where names the map in errors.
+func validateWeekdayKeys(weekdays map[string]PlanEntry, where string) error {
+	selectors, err := parseWeekdaySelectors(weekdays)
+	if err != nil {
+		return &PlanError{
+			Message: generic.Ptr("invalid weekday key in " + where),
+			Err:     ErrInvalidPlanEntry,
+			Cause:   err,
+		}
+	}
+	for i, a := range selectors {
+		for _, b := range selectors[i+1:] {
+			if len(a.days) == len(b.days) && a.overlaps(b) {
+				return &PlanError{
+					Message: generic.Ptr("weekdays " + a.key + " and " + b.key + " overlap in " + where),
+					Err:     ErrInvalidPlanEntry,
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// coveredWeekdays returns the days of the week the keys of a weekdays map cover. The keys must already be valid.
+func coveredWeekdays(weekdays map[string]PlanEntry) []string {
+	covered := []string{}
+	selectors, _ := parseWeekdaySelectors(weekdays)
+	for _, day := range weekOrder {
+		if slices.ContainsFunc(selectors, func(s weekdaySelector) bool { return slices.Contains(s.days, day) }) {
+			covered = append(covered, string(day))
+		}
+	}
+	return covered
+}
+
+// mergeWeekdays returns the base weekday entries with those of the overlay added or replaced. A base key whose
+// days an overlay key covers is dropped, so that a season's "mon-fri" replaces its group's "fri".
+func mergeWeekdays(base, overlay map[string]PlanEntry) map[string]PlanEntry {
+	if len(overlay) == 0 {
+		return base
+	}
+	overlaySelectors, _ := parseWeekdaySelectors(overlay)
+	merged := make(map[string]PlanEntry, len(base)+len(overlay))
+	for key, entry := range base {
+		selector, err := parseWeekdaySelector(key)
+		if err == nil && slices.ContainsFunc(overlaySelectors, func(s weekdaySelector) bool {
+			return s.covers(selector.days)
+		}) {
+			continue
+		}
+		merged[key] = entry
+	}
+	for key, entry := range overlay {
+		merged[key] = entry
+	}
+	return merged
+}
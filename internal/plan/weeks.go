@@ -0,0 +1,173 @@
+package plan
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/julianstephens/go-utils/generic"
+)
+
+// WeekPlan holds the entries for some weeks of a season. Its keys in SeasonPlan.Weeks select the weeks by
+// SeasonWeek number: a single week ("3"), a range ("1-2") or the last week of the season ("last").
+type WeekPlan struct {
+	Weekdays map[string]PlanEntry `yaml:"weekdays"`
+	Fallback *PlanEntry           `yaml:"fallback"`
+}
+
+// weekSelector is a parsed key of SeasonPlan.Weeks.
+type weekSelector struct {
+	key      string
+	from, to int
+	last     bool
+}
+
+// parseWeekSelector parses a week key: a week number, a range of week numbers, or "last".
+func parseWeekSelector(key string) (weekSelector, error) {
+	trimmed := strings.ToLower(strings.TrimSpace(key))
+	if trimmed == "last" {
+		return weekSelector{key: key, last: true}, nil
+	}
+
+	fromText, toText, isRange := strings.Cut(trimmed, "-")
+	if !isRange {
+		toText = fromText
+	}
+	from, fromErr := strconv.Atoi(strings.TrimSpace(fromText))
+	to, toErr := strconv.Atoi(strings.TrimSpace(toText))
+	if fromErr != nil || toErr != nil || from < 1 || to > 53 || from > to {
+		return weekSelector{}, &PlanError{
+			Message: generic.Ptr("invalid week " + key + ": expected a week number, a range such as 1-2, or last"),
+			Err:     ErrInvalidPlanEntry,
+		}
+	}
+	return weekSelector{key: key, from: from, to: to}, nil
+}
+
+// span returns the number of weeks a numbered selector covers; a narrower selector is more specific.
+func (s weekSelector) span() int {
+	return s.to - s.from + 1
+}
+
+// specificity orders selectors for matching: single weeks first, then "last", then ranges from narrowest to widest.
+func (s weekSelector) specificity() int {
+	switch {
+	case s.last:
+		return 2
+	case s.span() == 1:
+		return 1
+	default:
+		return 2 + s.span()
+	}
+}
+
+// weekSelectors parses the keys of the season's week plans in the order they are matched.
+func (p *SeasonPlan) weekSelectors() ([]weekSelector, error) {
+	selectors := make([]weekSelector, 0, len(p.Weeks))
+	for key := range p.Weeks {
+		selector, err := parseWeekSelector(key)
+		if err != nil {
+			return nil, err
+		}
+		selectors = append(selectors, selector)
+	}
+	sort.Slice(selectors, func(i, j int) bool {
+		if selectors[i].specificity() != selectors[j].specificity() {
+			return selectors[i].specificity() < selectors[j].specificity()
+		}
+		return selectors[i].from < selectors[j].from
+	})
+	return selectors, nil
+}
+
+// MatchWeeks returns the week plans that apply to a week of the season, most specific first. lastWeek is called
+// only if the season has a "last" week plan, to find the number of the season's last week.
+func (p *SeasonPlan) MatchWeeks(week int, lastWeek func() (int, error)) ([]MatchedWeek, error) {
+	selectors, err := p.weekSelectors()
+	if err != nil {
+		return nil, err
+	}
+
+	matches := []MatchedWeek{}
+	for _, selector := range selectors {
+		if selector.last {
+			last, err := lastWeek()
+			if err != nil {
+				return nil, err
+			}
+			if week == last {
+				matches = append(matches, MatchedWeek{WeekPlan: p.Weeks[selector.key], Key: selector.key})
+			}
+			continue
+		}
+		if week >= selector.from && week <= selector.to {
+			matches = append(matches, MatchedWeek{WeekPlan: p.Weeks[selector.key], Key: selector.key})
+		}
+	}
+	return matches, nil
+}
+
+// validateWeeks checks the week plans of a season: that their keys parse, that two keys of the same specificity
+// do not overlap (which would make the match ambiguous), and that their entries are valid.
+func (p *SeasonPlan) validateWeeks(seasonName string) error {
+	selectors, err := p.weekSelectors()
+	if err != nil {
+		return err
+	}
+
+	for i, a := range selectors {
+		for _, b := range selectors[i+1:] {
+			if a.specificity() != b.specificity() {
+				continue
+			}
+			if a.last || a.from <= b.to && b.from <= a.to {
+				return &PlanError{
+					Message: generic.Ptr("weeks " + a.key + " and " + b.key + " overlap in season " + seasonName),
+					Err:     ErrInvalidPlanEntry,
+				}
+			}
+		}
+	}
+
+	for _, selector := range selectors {
+		weekPlan := p.Weeks[selector.key]
+		if len(weekPlan.Weekdays) == 0 && weekPlan.Fallback == nil {
+			return &PlanError{
+				Message: generic.Ptr(
+					"week " + selector.key + " in season " + seasonName + " must have a weekday entry or a fallback",
+				),
+				Err: ErrInvalidPlanEntry,
+			}
+		}
+		if err := validateWeekdayKeys(
+			weekPlan.Weekdays,
+			"week "+selector.key+" of season "+seasonName,
+		); err != nil {
+			return err
+		}
+		for weekday, entry := range weekPlan.Weekdays {
+			if _, err := entry.Validate(); err != nil {
+				return &PlanError{
+					Message: generic.Ptr(
+						"invalid plan entry in week " + selector.key + " of season " + seasonName +
+							" for weekday " + weekday,
+					),
+					Err:   ErrInvalidPlanEntry,
+					Cause: err,
+				}
+			}
+		}
+		if weekPlan.Fallback != nil {
+			if _, err := weekPlan.Fallback.Validate(); err != nil {
+				return &PlanError{
+					Message: generic.Ptr(
+						"invalid fallback plan entry in week " + selector.key + " of season " + seasonName,
+					),
+					Err:   ErrInvalidPlanEntry,
+					Cause: err,
+				}
+			}
+		}
+	}
+	return nil
+}
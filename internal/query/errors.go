@@ -0,0 +1,25 @@
+package query
+
+import "fmt"
+
+var (
+	ErrParseFailed      = fmt.Errorf("failed to parse query")
+	ErrEvaluationFailed = fmt.Errorf("failed to evaluate query")
+)
+
+type QueryError struct {
+	Message *string
+	Err     error
+	Cause   error
+}
+
+func (e *QueryError) Error() string {
+	if e.Message != nil {
+		return fmt.Sprintf("query error: %s: %v (cause: %v)", *e.Message, e.Err, e.Cause)
+	}
+	return fmt.Sprintf("query error: %v (cause: %v)", e.Err, e.Cause)
+}
+
+func (e *QueryError) Unwrap() error {
+	return e.Err
+}
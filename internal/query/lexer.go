@@ -0,0 +1,97 @@
+package query
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/julianstephens/go-utils/generic"
+)
+
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenString
+	tokenOperator
+	tokenLeftParen
+	tokenRightParen
+	tokenComma
+	tokenLeftBracket
+	tokenRightBracket
+	tokenEOF
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+// operators are the comparison and logical operators, longest first so that "<=" is not read as "<" and "!=" is
+// not read as "!".
+var operators = []string{"!=", "<=", ">=", "==", "&&", "||", "=", "<", ">", "~", "!"}
+
+// lex splits a query expression into tokens. Words run until whitespace, punctuation or an operator, so anchor
+// offsets ("easter+3") and dates ("2025-03-01") are single words.
+func lex(expr string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(expr); {
+		c := rune(expr[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '(':
+			tokens = append(tokens, token{tokenLeftParen, "(", i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{tokenRightParen, ")", i})
+			i++
+		case c == ',':
+			tokens = append(tokens, token{tokenComma, ",", i})
+			i++
+		case c == '[':
+			tokens = append(tokens, token{tokenLeftBracket, "[", i})
+			i++
+		case c == ']':
+			tokens = append(tokens, token{tokenRightBracket, "]", i})
+			i++
+		case c == '"' || c == '\'':
+			end := strings.IndexRune(expr[i+1:], c)
+			if end < 0 {
+				return nil, &QueryError{
+					Message: generic.Ptr("unterminated string at position " + strconv.Itoa(i)),
+					Err:     ErrParseFailed,
+				}
+			}
+			tokens = append(tokens, token{tokenString, expr[i+1 : i+1+end], i})
+			i += end + 2
+		default:
+			if op := operatorAt(expr, i); op != "" {
+				tokens = append(tokens, token{tokenOperator, op, i})
+				i += len(op)
+				continue
+			}
+			start := i
+			for i < len(expr) && !isDelimiter(expr, i) {
+				i++
+			}
+			tokens = append(tokens, token{tokenWord, expr[start:i], start})
+		}
+	}
+	return append(tokens, token{tokenEOF, "", len(expr)}), nil
+}
+
+func operatorAt(expr string, i int) string {
+	for _, op := range operators {
+		if strings.HasPrefix(expr[i:], op) {
+			return op
+		}
+	}
+	return ""
+}
+
+func isDelimiter(expr string, i int) bool {
+	c := rune(expr[i])
+	return unicode.IsSpace(c) || strings.ContainsRune("(),[]\"'", c) || operatorAt(expr, i) != ""
+}
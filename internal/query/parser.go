@@ -0,0 +1,294 @@
+package query
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/julianstephens/go-utils/generic"
+
+	"github.com/julianstephens/liturgical-time-index/internal"
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+)
+
+// Parse parses a query expression. The grammar is:
+//
+//	expr       = and { ( "or" | "||" ) and }
+//	and        = unary { ( "and" | "&&" ) unary }
+//	unary      = ( "not" | "!" ) unary | "(" expr ")" | between | comparison | membership
+//	between    = "between" "(" dateRef "," dateRef ")"
+//	comparison = field operator value
+//	membership = field "in" "[" value { "," value } "]"
+//
+// Keywords are case-insensitive, and "==" is the same as "=". Values may be quoted with single or double quotes.
+func Parse(expr string) (*Query, error) {
+	tokens, err := lex(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	parsed, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if next := p.peek(); next.kind != tokenEOF {
+		return nil, p.errorf(next, "unexpected "+strconv.Quote(next.value))
+	}
+	return &Query{source: expr, expr: parsed}, nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) keyword(word string) bool {
+	t := p.peek()
+	if t.kind == tokenWord && strings.EqualFold(t.value, word) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) operator(op string) bool {
+	t := p.peek()
+	if t.kind == tokenOperator && t.value == op {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(kind tokenKind, what string) (token, error) {
+	t := p.next()
+	if t.kind != kind {
+		return t, p.errorf(t, "expected "+what)
+	}
+	return t, nil
+}
+
+func (p *parser) errorf(t token, message string) error {
+	return &QueryError{
+		Message: generic.Ptr(message + " at position " + strconv.Itoa(t.pos)),
+		Err:     ErrParseFailed,
+	}
+}
+
+func (p *parser) parseOr() (expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("or") || p.operator("||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orExpr{left, right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (expr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("and") || p.operator("&&") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andExpr{left, right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (expr, error) {
+	if p.keyword("not") || p.operator("!") {
+		inner, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notExpr{inner}, nil
+	}
+
+	if p.peek().kind == tokenLeftParen {
+		p.next()
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRightParen, "\")\""); err != nil {
+			return nil, err
+		}
+		return inner, nil
+	}
+
+	if p.keyword("between") {
+		return p.parseBetween()
+	}
+	return p.parseComparison()
+}
+
+func (p *parser) parseBetween() (expr, error) {
+	if _, err := p.expect(tokenLeftParen, "\"(\" after between"); err != nil {
+		return nil, err
+	}
+	fromToken, err := p.expect(tokenWord, "a start date")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokenComma, "\",\""); err != nil {
+		return nil, err
+	}
+	toToken, err := p.expect(tokenWord, "an end date")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokenRightParen, "\")\""); err != nil {
+		return nil, err
+	}
+
+	from, err := parseDateRef(fromToken.value)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseDateRef(toToken.value)
+	if err != nil {
+		return nil, err
+	}
+	return betweenExpr{from, to}, nil
+}
+
+func (p *parser) parseComparison() (expr, error) {
+	fieldToken, err := p.expect(tokenWord, "a field name")
+	if err != nil {
+		return nil, err
+	}
+	field := strings.ToLower(fieldToken.value)
+	if alias, ok := fieldAliases[field]; ok {
+		field = alias
+	}
+	ops, ok := Fields[field]
+	if !ok {
+		return nil, p.errorf(fieldToken, "unknown field "+strconv.Quote(fieldToken.value))
+	}
+
+	if p.keyword("in") {
+		return p.parseMembership(field)
+	}
+
+	opToken, err := p.expect(tokenOperator, "an operator after "+field)
+	if err != nil {
+		return nil, err
+	}
+	op := opToken.value
+	if op == "==" {
+		op = "="
+	}
+	if !slices.Contains(ops, op) {
+		return nil, p.errorf(opToken, "operator "+opToken.value+" is not supported for "+field)
+	}
+	return p.parseValue(field, op)
+}
+
+// parseMembership parses the list of an "in" test, matching a day whose field equals any of the values.
+func (p *parser) parseMembership(field string) (expr, error) {
+	if _, err := p.expect(tokenLeftBracket, "\"[\" after in"); err != nil {
+		return nil, err
+	}
+	var membership expr
+	for {
+		value, err := p.parseValue(field, "=")
+		if err != nil {
+			return nil, err
+		}
+		if membership == nil {
+			membership = value
+		} else {
+			membership = orExpr{membership, value}
+		}
+		if p.peek().kind != tokenComma {
+			break
+		}
+		p.next()
+	}
+	if _, err := p.expect(tokenRightBracket, "\"]\""); err != nil {
+		return nil, err
+	}
+	return membership, nil
+}
+
+// parseValue parses the value compared with a field.
+func (p *parser) parseValue(field, op string) (expr, error) {
+	valueToken := p.next()
+	if valueToken.kind != tokenWord && valueToken.kind != tokenString {
+		return nil, p.errorf(valueToken, "expected a value for "+field)
+	}
+	if field == "date" {
+		if ref, err := parseDateRef(valueToken.value); err == nil && ref.date == nil {
+			return compareExpr{field: field, op: op, value: valueToken.value, ref: &ref}, nil
+		}
+	}
+	value, err := normaliseValue(field, valueToken.value)
+	if err != nil {
+		return nil, &QueryError{
+			Message: generic.Ptr("invalid value " + strconv.Quote(valueToken.value) + " for " + field),
+			Err:     ErrParseFailed,
+			Cause:   err,
+		}
+	}
+	return compareExpr{field: field, op: op, value: value}, nil
+}
+
+// normaliseValue checks a comparison value and converts it to the form stored on a DayKey, so that for example
+// weekday=Friday matches "fri".
+func normaliseValue(field, value string) (string, error) {
+	switch field {
+	case "season":
+		season, err := calendar.ParseSeason(value)
+		return string(season), err
+	case "weekday":
+		weekday, err := calendar.ParseWeekday(value)
+		return string(weekday), err
+	case "colour", "tradition", "tags":
+		return strings.ToLower(value), nil
+	case "cycle":
+		value = strings.ToUpper(value)
+		if !slices.Contains([]string{"A", "B", "C"}, value) {
+			return "", &QueryError{
+				Message: generic.Ptr("expected A, B or C"),
+				Err:     ErrParseFailed,
+			}
+		}
+		return value, nil
+	case "date":
+		if _, err := time.Parse(internal.DateFormat, value); err != nil {
+			return "", err
+		}
+		return value, nil
+	case "celebration":
+		return value, nil
+	default:
+		if _, err := strconv.Atoi(value); err != nil {
+			return "", err
+		}
+		return value, nil
+	}
+}
@@ -0,0 +1,297 @@
+// Package query implements a small expression language for selecting days of the generated calendar, e.g.
+// `season=lent and weekday=fri`, `week>=5 or colour=rose` or `between(ash-wednesday, easter)`. The same
+// expressions can be written `season == "lent" && weekday in ["fri"]`.
+package query
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/julianstephens/go-utils/generic"
+
+	"github.com/julianstephens/liturgical-time-index/internal"
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+)
+
+// Fields lists the day attributes a query can compare, with the operators each accepts.
+var Fields = map[string][]string{
+	"date":          {"=", "!=", "<", "<=", ">", ">="},
+	"season":        {"=", "!="},
+	"week":          {"=", "!=", "<", "<=", ">", ">="},
+	"weekday":       {"=", "!="},
+	"colour":        {"=", "!="},
+	"celebration":   {"=", "!=", "~"},
+	"tradition":     {"=", "!="},
+	"year":          {"=", "!=", "<", "<=", ">", ">="},
+	"month":         {"=", "!=", "<", "<=", ">", ">="},
+	"day":           {"=", "!=", "<", "<=", ">", ">="},
+	"cycle":         {"=", "!="},
+	"weekday_cycle": {"=", "!="},
+	"tags":          {"=", "!="},
+}
+
+// fieldAliases maps alternative spellings to the canonical field names.
+var fieldAliases = map[string]string{
+	"color":       "colour",
+	"season_week": "week",
+	"tag":         "tags",
+}
+
+// Query is a parsed query expression.
+type Query struct {
+	source string
+	expr   expr
+}
+
+// String returns the expression the query was parsed from.
+func (q *Query) String() string {
+	return q.source
+}
+
+// Match reports whether the day satisfies the query. The engine resolves anchors such as Easter for the
+// day's year and tradition.
+func (q *Query) Match(ce *calendar.CalendarEngine, day calendar.DayKey) (bool, error) {
+	return q.MatchTagged(ce, day, nil)
+}
+
+// MatchTagged reports whether the day satisfies the query, with tags giving the tags the day carries in a plan.
+// `tags=fast` matches a day that has the tag, and `tags!=fast` one that does not.
+func (q *Query) MatchTagged(ce *calendar.CalendarEngine, day calendar.DayKey, tags []string) (bool, error) {
+	return q.expr.eval(ce, subject{day: day, tags: tags})
+}
+
+// Filter returns the days that satisfy the query.
+func (q *Query) Filter(ce *calendar.CalendarEngine, days []calendar.DayKey) ([]calendar.DayKey, error) {
+	result := []calendar.DayKey{}
+	for _, day := range days {
+		ok, err := q.Match(ce, day)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			result = append(result, day)
+		}
+	}
+	return result, nil
+}
+
+// subject is the day an expression is evaluated against, with the tags it carries in a plan.
+type subject struct {
+	day  calendar.DayKey
+	tags []string
+}
+
+type expr interface {
+	eval(ce *calendar.CalendarEngine, s subject) (bool, error)
+}
+
+type andExpr struct{ left, right expr }
+
+func (e andExpr) eval(ce *calendar.CalendarEngine, s subject) (bool, error) {
+	ok, err := e.left.eval(ce, s)
+	if err != nil || !ok {
+		return false, err
+	}
+	return e.right.eval(ce, s)
+}
+
+type orExpr struct{ left, right expr }
+
+func (e orExpr) eval(ce *calendar.CalendarEngine, s subject) (bool, error) {
+	ok, err := e.left.eval(ce, s)
+	if err != nil || ok {
+		return ok, err
+	}
+	return e.right.eval(ce, s)
+}
+
+type notExpr struct{ inner expr }
+
+func (e notExpr) eval(ce *calendar.CalendarEngine, s subject) (bool, error) {
+	ok, err := e.inner.eval(ce, s)
+	return !ok, err
+}
+
+// compareExpr compares a day attribute with a value normalised at parse time. A date compared with an anchor or
+// an MM-DD date (date>=ash-wednesday) holds the reference, resolved in the day's year.
+type compareExpr struct {
+	field string
+	op    string
+	value string
+	ref   *dateRef
+}
+
+func (e compareExpr) eval(ce *calendar.CalendarEngine, s subject) (bool, error) {
+	day := s.day
+	parsed, err := time.Parse(internal.DateFormat, day.Date)
+	if err != nil {
+		return false, &QueryError{
+			Message: generic.Ptr("invalid date: " + day.Date),
+			Err:     ErrEvaluationFailed,
+			Cause:   err,
+		}
+	}
+
+	switch e.field {
+	case "date":
+		if e.ref != nil {
+			date, err := e.ref.resolve(ce, parsed.Year(), day.Tradition)
+			if err != nil {
+				return false, err
+			}
+			return compareStrings(day.Date, e.op, date.Format(internal.DateFormat)), nil
+		}
+		return compareStrings(day.Date, e.op, e.value), nil
+	case "season":
+		return compareStrings(string(day.Season), e.op, e.value), nil
+	case "weekday":
+		return compareStrings(string(day.Weekday), e.op, e.value), nil
+	case "colour":
+		return compareStrings(string(day.Colour), e.op, e.value), nil
+	case "tradition":
+		return compareStrings(string(day.Tradition), e.op, e.value), nil
+	case "celebration":
+		if e.op == "~" {
+			return strings.Contains(strings.ToLower(day.Celebration), strings.ToLower(e.value)), nil
+		}
+		return compareStrings(strings.ToLower(day.Celebration), e.op, strings.ToLower(e.value)), nil
+	case "tags":
+		hasTag := generic.Any(s.tags, func(tag string) bool { return strings.EqualFold(tag, e.value) })
+		return hasTag == (e.op == "="), nil
+	case "cycle", "weekday_cycle":
+		sunday, weekday, err := ce.LectionaryCycle(day)
+		if err != nil {
+			return false, &QueryError{
+				Err:   ErrEvaluationFailed,
+				Cause: err,
+			}
+		}
+		if e.field == "cycle" {
+			return compareStrings(sunday, e.op, e.value), nil
+		}
+		return compareInts(weekday, e.op, e.value), nil
+	case "week":
+		return compareInts(day.SeasonWeek, e.op, e.value), nil
+	case "year":
+		return compareInts(parsed.Year(), e.op, e.value), nil
+	case "month":
+		return compareInts(int(parsed.Month()), e.op, e.value), nil
+	default:
+		return compareInts(parsed.Day(), e.op, e.value), nil
+	}
+}
+
+func compareStrings(actual, op, expected string) bool {
+	switch op {
+	case "=":
+		return actual == expected
+	case "!=":
+		return actual != expected
+	case "<":
+		return actual < expected
+	case "<=":
+		return actual <= expected
+	case ">":
+		return actual > expected
+	default:
+		return actual >= expected
+	}
+}
+
+func compareInts(actual int, op, expected string) bool {
+	value, _ := strconv.Atoi(expected)
+	switch op {
+	case "=":
+		return actual == value
+	case "!=":
+		return actual != value
+	case "<":
+		return actual < value
+	case "<=":
+		return actual <= value
+	case ">":
+		return actual > value
+	default:
+		return actual >= value
+	}
+}
+
+// betweenExpr matches the days from one date to another, inclusive. When the range wraps around the end of the
+// year (between(advent1, epiphany)), it matches the days on or after the start or on or before the end.
+type betweenExpr struct{ from, to dateRef }
+
+func (e betweenExpr) eval(ce *calendar.CalendarEngine, s subject) (bool, error) {
+	day := s.day
+	date, err := time.Parse(internal.DateFormat, day.Date)
+	if err != nil {
+		return false, &QueryError{
+			Message: generic.Ptr("invalid date: " + day.Date),
+			Err:     ErrEvaluationFailed,
+			Cause:   err,
+		}
+	}
+	from, err := e.from.resolve(ce, date.Year(), day.Tradition)
+	if err != nil {
+		return false, err
+	}
+	to, err := e.to.resolve(ce, date.Year(), day.Tradition)
+	if err != nil {
+		return false, err
+	}
+
+	if to.Before(from) {
+		return !date.Before(from) || !date.After(to), nil
+	}
+	return !date.Before(from) && !date.After(to), nil
+}
+
+// dateRef is an anchor with an optional day offset ("easter+3"), a date in every year ("12-24"), or a
+// single date ("2025-12-24").
+type dateRef struct {
+	anchor calendar.AnchorOffset
+	month  time.Month
+	day    int
+	date   *time.Time
+}
+
+func parseDateRef(word string) (dateRef, error) {
+	if date, err := time.Parse(internal.DateFormat, word); err == nil {
+		return dateRef{date: &date}, nil
+	}
+	if date, err := time.Parse("01-02", word); err == nil {
+		return dateRef{month: date.Month(), day: date.Day()}, nil
+	}
+
+	anchor, err := calendar.ParseAnchorOffset(word)
+	if err != nil {
+		return dateRef{}, &QueryError{
+			Message: generic.Ptr("expected an anchor, MM-DD or YYYY-MM-DD date, got " + word),
+			Err:     ErrParseFailed,
+			Cause:   err,
+		}
+	}
+	return dateRef{anchor: anchor}, nil
+}
+
+func (r dateRef) resolve(
+	ce *calendar.CalendarEngine,
+	year int,
+	tradition calendar.CalendarTradition,
+) (time.Time, error) {
+	switch {
+	case r.date != nil:
+		return *r.date, nil
+	case r.month != 0:
+		return time.Date(year, r.month, r.day, 0, 0, 0, 0, time.UTC), nil
+	}
+
+	date, err := ce.AnchorOffsetDate(r.anchor, year, tradition)
+	if err != nil {
+		return time.Time{}, &QueryError{
+			Err:   ErrEvaluationFailed,
+			Cause: err,
+		}
+	}
+	return date, nil
+}
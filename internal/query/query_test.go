@@ -0,0 +1,152 @@
+package query_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/julianstephens/liturgical-time-index/internal/calendar"
+	"github.com/julianstephens/liturgical-time-index/internal/query"
+)
+
+func TestParseInvalid(t *testing.T) {
+	testCases := []string{
+		"",
+		"season",
+		"season=",
+		"season=lenten",
+		"weekday=funday",
+		"week>=five",
+		"season>lent",
+		"colour~rose",
+		"feast=easter",
+		"season=lent and",
+		"(season=lent",
+		"between(easter)",
+		"between(easter, nowhere)",
+		"celebration='unterminated",
+		"season=lent week=1",
+		"weekday in [fri",
+		"weekday in []",
+		"cycle=D",
+		"season == lent &&",
+		"date>=nowhere",
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc, func(t *testing.T) {
+			_, err := query.Parse(tc)
+			if err == nil {
+				t.Fatalf("Expected error for %q", tc)
+			}
+			if !errors.Is(err, query.ErrParseFailed) {
+				t.Errorf("Expected query.ErrParseFailed, got %v", err)
+			}
+		})
+	}
+}
+
+func TestQueryFilter(t *testing.T) {
+	ce := calendar.NewCalendarEngine()
+	days, err := ce.GenerateRomanCalendar("2025", calendar.RomanCalendar)
+	if err != nil {
+		t.Fatalf("GenerateRomanCalendar failed: %v", err)
+	}
+
+	// Easter 2025 is 20 April; Ash Wednesday is 5 March.
+	testCases := []struct {
+		expr  string
+		count int
+		first string
+	}{
+		{"season=lent and weekday=fri", 6, "2025-03-07"},
+		{"season=Lent and weekday=Friday and week>=5", 2, "2025-04-04"},
+		{"between(ash-wednesday, easter)", 47, "2025-03-05"},
+		{"between(easter+1, easter+7) and not weekday=sun", 6, "2025-04-21"},
+		{"between(pentecost-9, pentecost-9)", 1, "2025-05-30"},
+		{"between(advent1, epiphany-1)", 37, "2025-01-01"},
+		{"between(12-24, 12-26)", 3, "2025-12-24"},
+		{"colour=rose", 2, "2025-03-30"},
+		{"color=red or celebration~'holy innocents'", 6, "2025-04-13"},
+		{"(season=advent or season=christmastide) and month=12 and day<3", 2, "2025-12-01"},
+		{"date>=2025-12-30", 2, "2025-12-30"},
+		{`celebration="Christ the King"`, 1, "2025-11-23"},
+		{`season == "lent" && week >= 5 && weekday in ["fri"]`, 2, "2025-04-04"},
+		{"weekday in [sat, sun] && month == 1", 8, "2025-01-04"},
+		{"!(weekday == sun) || date == easter+7", 314, "2025-01-01"},
+		{"date == easter+7", 1, "2025-04-27"},
+		{"date >= advent1", 32, "2025-11-30"},
+		{"cycle == A", 32, "2025-11-30"},
+		{"weekday_cycle=1 and month=1", 31, "2025-01-01"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expr, func(t *testing.T) {
+			q, err := query.Parse(tc.expr)
+			if err != nil {
+				t.Fatalf("Parse failed: %v", err)
+			}
+			matches, err := q.Filter(ce, days)
+			if err != nil {
+				t.Fatalf("Filter failed: %v", err)
+			}
+			if len(matches) != tc.count {
+				t.Errorf("Expected %d matches, got %d", tc.count, len(matches))
+			}
+			if len(matches) > 0 && matches[0].Date != tc.first {
+				t.Errorf("Expected first match %s, got %s", tc.first, matches[0].Date)
+			}
+		})
+	}
+}
+
+func TestQueryMissingAnchor(t *testing.T) {
+	ce := calendar.NewCalendarEngine()
+	q, err := query.Parse("between(ash-wednesday, easter)")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	day, err := ce.GetRomanDay("2025-03-10", calendar.AmbrosianCalendar)
+	if err != nil {
+		t.Fatalf("GetRomanDay failed: %v", err)
+	}
+	if _, err := q.Match(ce, *day); !errors.Is(err, query.ErrEvaluationFailed) {
+		t.Errorf("Expected query.ErrEvaluationFailed for a tradition without Ash Wednesday, got %v", err)
+	}
+}
+
+func TestQueryTags(t *testing.T) {
+	ce := calendar.NewCalendarEngine()
+	day, err := ce.GetRomanDay("2025-03-07", calendar.RomanCalendar)
+	if err != nil {
+		t.Fatalf("GetRomanDay failed: %v", err)
+	}
+
+	testCases := []struct {
+		expr     string
+		tags     []string
+		expected bool
+	}{
+		{"tags == fast", []string{"lent", "fast"}, true},
+		{"tag = Fast", []string{"fast"}, true},
+		{"tags != fast", []string{"lent"}, true},
+		{"tags in [feast, fast] and weekday=fri", []string{"fast"}, true},
+		{"tags == fast", nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expr, func(t *testing.T) {
+			q, err := query.Parse(tc.expr)
+			if err != nil {
+				t.Fatalf("Parse failed: %v", err)
+			}
+			ok, err := q.MatchTagged(ce, *day, tc.tags)
+			if err != nil {
+				t.Fatalf("MatchTagged failed: %v", err)
+			}
+			if ok != tc.expected {
+				t.Errorf("Expected %v for tags %v, got %v", tc.expected, tc.tags, ok)
+			}
+		})
+	}
+}